@@ -113,8 +113,10 @@ const (
 	ResourceKindCronJob                 = "cronjob"
 	ResourceKindLimitRange              = "limitrange"
 	ResourceKindNamespace               = "namespace"
+	ResourceKindNetworkPolicy           = "networkpolicy"
 	ResourceKindNode                    = "node"
 	ResourceKindPersistentVolumeClaim   = "persistentvolumeclaim"
+	ResourceKindPodDisruptionBudget     = "poddisruptionbudget"
 	ResourceKindPersistentVolume        = "persistentvolume"
 	ResourceKindPod                     = "pod"
 	ResourceKindReplicaSet              = "replicaset"
@@ -122,6 +124,7 @@ const (
 	ResourceKindResourceQuota           = "resourcequota"
 	ResourceKindSecret                  = "secret"
 	ResourceKindService                 = "service"
+	ResourceKindServiceAccount          = "serviceaccount"
 	ResourceKindStatefulSet             = "statefulset"
 	ResourceKindStorageClass            = "storageclass"
 	ResourceKindRbacRole                = "role"
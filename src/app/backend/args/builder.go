@@ -48,6 +48,24 @@ func (self *holderBuilder) SetMetricClientCheckPeriod(period int) *holderBuilder
 	return self
 }
 
+// SetApiRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holderBuilder) SetApiRequestTimeout(timeout int) *holderBuilder {
+	self.holder.apiRequestTimeout = timeout
+	return self
+}
+
+// SetGzipLevel 'gzip-level' argument of Dashboard binary.
+func (self *holderBuilder) SetGzipLevel(level int) *holderBuilder {
+	self.holder.gzipLevel = level
+	return self
+}
+
+// SetMaxRequestBody 'max-request-body' argument of Dashboard binary.
+func (self *holderBuilder) SetMaxRequestBody(size int) *holderBuilder {
+	self.holder.maxRequestBody = size
+	return self
+}
+
 // SetInsecureBindAddress 'insecure-bind-address' argument of Dashboard binary.
 func (self *holderBuilder) SetInsecureBindAddress(ip net.IP) *holderBuilder {
 	self.holder.insecureBindAddress = ip
@@ -114,6 +132,12 @@ func (self *holderBuilder) SetAuthenticationMode(authMode []string) *holderBuild
 	return self
 }
 
+// SetCorsAllowedOrigins 'cors-allowed-origins' argument of Dashboard binary.
+func (self *holderBuilder) SetCorsAllowedOrigins(corsAllowedOrigins []string) *holderBuilder {
+	self.holder.corsAllowedOrigins = corsAllowedOrigins
+	return self
+}
+
 // SetAutoGenerateCertificates 'auto-generate-certificates' argument of Dashboard binary.
 func (self *holderBuilder) SetAutoGenerateCertificates(autoGenerateCertificates bool) *holderBuilder {
 	self.holder.autoGenerateCertificates = autoGenerateCertificates
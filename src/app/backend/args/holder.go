@@ -29,6 +29,9 @@ type holder struct {
 	port                    int
 	tokenTTL                int
 	metricClientCheckPeriod int
+	apiRequestTimeout       int
+	gzipLevel               int
+	maxRequestBody          int
 
 	insecureBindAddress net.IP
 	bindAddress         net.IP
@@ -44,6 +47,8 @@ type holder struct {
 
 	authenticationMode []string
 
+	corsAllowedOrigins []string
+
 	autoGenerateCertificates  bool
 	enableInsecureLogin       bool
 	disableSettingsAuthorizer bool
@@ -69,6 +74,21 @@ func (self *holder) GetMetricClientCheckPeriod() int {
 	return self.metricClientCheckPeriod
 }
 
+// GetApiRequestTimeout 'request-timeout' argument of Dashboard binary.
+func (self *holder) GetApiRequestTimeout() int {
+	return self.apiRequestTimeout
+}
+
+// GetGzipLevel 'gzip-level' argument of Dashboard binary.
+func (self *holder) GetGzipLevel() int {
+	return self.gzipLevel
+}
+
+// GetMaxRequestBody 'max-request-body' argument of Dashboard binary.
+func (self *holder) GetMaxRequestBody() int {
+	return self.maxRequestBody
+}
+
 // GetInsecureBindAddress 'insecure-bind-address' argument of Dashboard binary.
 func (self *holder) GetInsecureBindAddress() net.IP {
 	return self.insecureBindAddress
@@ -132,6 +152,11 @@ func (self *holder) GetAuthenticationMode() []string {
 	return self.authenticationMode
 }
 
+// GetCorsAllowedOrigins 'cors-allowed-origins' argument of Dashboard binary.
+func (self *holder) GetCorsAllowedOrigins() []string {
+	return self.corsAllowedOrigins
+}
+
 // GetAutoGenerateCertificates 'auto-generate-certificates' argument of Dashboard binary.
 func (self *holder) GetAutoGenerateCertificates() bool {
 	return self.autoGenerateCertificates
@@ -45,6 +45,16 @@ type ResourceVerber interface {
 		object *runtime.Unknown) error
 	Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error)
 	Delete(kind string, namespaceSet bool, namespace string, name string) error
+	Apply(kind string, namespaceSet bool, namespace string, name string,
+		fieldManager string, force bool, patch []byte) error
+	Patch(kind string, namespaceSet bool, namespace string, name string, patch []byte) error
+	PatchJSON(kind string, namespaceSet bool, namespace string, name string, patch []byte) error
+	Count(kind string, namespaceSet bool, namespace string) (int, error)
+}
+
+// ResourceCount is the number of resources of some kind, used for badge counts in the UI.
+type ResourceCount struct {
+	Count int `json:"count"`
 }
 
 // CanIResponse is used to as response to check whether or not user is allowed to access given endpoint.
@@ -0,0 +1,107 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// customMetricsAPIPath is the root path of the aggregated custom metrics API served by metric
+// adapters, e.g. the Prometheus adapter.
+const customMetricsAPIPath = "/apis/custom.metrics.k8s.io/v1beta1"
+
+// CustomMetricsClient reads metric values from the custom metrics API. These are the metrics
+// that autoscaling/v2beta1 horizontal pod autoscalers can act on.
+type CustomMetricsClient struct {
+	client RESTClient
+}
+
+// NewCustomMetricsClient creates a new custom metrics client that reads metrics through the
+// given REST client.
+func NewCustomMetricsClient(client RESTClient) *CustomMetricsClient {
+	return &CustomMetricsClient{client: client}
+}
+
+// MetricValue is a single value of a custom metric describing a namespaced object.
+type MetricValue struct {
+	// Namespace, kind (as a lowercase plural resource, e.g. "pods") and name of the object
+	// the metric describes.
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+
+	// Name of the metric.
+	MetricName string `json:"metricName"`
+
+	// Value of the metric at the time it was collected.
+	Value string `json:"value"`
+
+	// Time at which the metric was collected.
+	Timestamp metaV1.Time `json:"timestamp"`
+}
+
+// metricValueList mirrors the MetricValueList type of the custom metrics API closely enough to
+// decode responses without vendoring the k8s.io/metrics repository.
+type metricValueList struct {
+	Items []struct {
+		DescribedObject struct {
+			Kind      string `json:"kind"`
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+		} `json:"describedObject"`
+		MetricName string            `json:"metricName"`
+		Timestamp  metaV1.Time       `json:"timestamp"`
+		Value      resource.Quantity `json:"value"`
+	} `json:"items"`
+}
+
+// GetMetric returns the current value of the given custom metric for the object of the given
+// kind (as a lowercase plural resource, e.g. "pods") in the given namespace.
+func (self *CustomMetricsClient) GetMetric(namespace, kind, name, metricName string) (*MetricValue, error) {
+	raw, err := self.client.Get().
+		AbsPath(customMetricsAPIPath).
+		Namespace(namespace).
+		Resource(kind).
+		Name(name).
+		Suffix(metricName).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &metricValueList{}
+	if err := json.Unmarshal(raw, list); err != nil {
+		return nil, err
+	}
+
+	if len(list.Items) != 1 {
+		return nil, fmt.Errorf("Expected exactly 1 value for %s metric of %s %s/%s but got %d",
+			metricName, kind, namespace, name, len(list.Items))
+	}
+
+	item := list.Items[0]
+	return &MetricValue{
+		Namespace:  item.DescribedObject.Namespace,
+		Kind:       item.DescribedObject.Kind,
+		Name:       item.DescribedObject.Name,
+		MetricName: item.MetricName,
+		Value:      item.Value.String(),
+		Timestamp:  item.Timestamp,
+	}, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestGetMetricShouldReturnSingleValue(t *testing.T) {
+	body := `{
+		"kind": "MetricValueList",
+		"apiVersion": "custom.metrics.k8s.io/v1beta1",
+		"items": [{
+			"describedObject": {"kind": "Pod", "namespace": "foo", "name": "bar"},
+			"metricName": "queries-per-second",
+			"timestamp": "2017-11-30T10:00:00Z",
+			"value": "100m"
+		}]
+	}`
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}}
+	customMetricsClient := NewCustomMetricsClient(fakeClient)
+
+	metricValue, err := customMetricsClient.GetMetric("foo", "pods", "bar", "queries-per-second")
+
+	if err != nil {
+		t.Fatalf("Expected no error on custom metrics get but got %#v", err)
+	}
+	if metricValue.Namespace != "foo" || metricValue.Kind != "Pod" || metricValue.Name != "bar" {
+		t.Errorf("Unexpected described object on custom metrics get: %#v", metricValue)
+	}
+	if metricValue.MetricName != "queries-per-second" {
+		t.Errorf("Expected metric name %#v but got %#v", "queries-per-second",
+			metricValue.MetricName)
+	}
+	if metricValue.Value != "100m" {
+		t.Errorf("Expected metric value %#v but got %#v", "100m", metricValue.Value)
+	}
+}
+
+func TestGetMetricShouldRejectMultipleValues(t *testing.T) {
+	body := `{
+		"kind": "MetricValueList",
+		"apiVersion": "custom.metrics.k8s.io/v1beta1",
+		"items": []
+	}`
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}}
+	customMetricsClient := NewCustomMetricsClient(fakeClient)
+
+	_, err := customMetricsClient.GetMetric("foo", "pods", "bar", "queries-per-second")
+
+	if err == nil {
+		t.Fatal("Expected an error on custom metrics get without exactly one value, got nil")
+	}
+}
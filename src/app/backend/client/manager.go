@@ -19,8 +19,10 @@ import (
 	"errors"
 	"log"
 	"strings"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"k8s.io/api/authorization/v1"
@@ -205,6 +207,7 @@ func (self *clientManager) initConfig(cfg *rest.Config) {
 	cfg.Burst = DefaultBurst
 	cfg.ContentType = DefaultContentType
 	cfg.UserAgent = DefaultUserAgent + "/" + Version
+	cfg.Timeout = time.Duration(args.Holder.GetApiRequestTimeout()) * time.Second
 }
 
 // Returns rest Config based on provided apiserverHost and kubeConfigPath flags. If both are
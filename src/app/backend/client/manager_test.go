@@ -17,8 +17,10 @@ package client
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 )
 
 func TestNewClientManager(t *testing.T) {
@@ -185,3 +187,25 @@ func TestClientManager_InsecureClient(t *testing.T) {
 		t.Fatalf("InsecureClient(): Expected insecure client not to be nil")
 	}
 }
+
+func TestConfigApiRequestTimeout(t *testing.T) {
+	args.GetHolderBuilder().SetApiRequestTimeout(30)
+	defer args.GetHolderBuilder().SetApiRequestTimeout(0)
+
+	manager := NewClientManager("", "https://localhost:8080")
+	cfg, err := manager.Config(&restful.Request{
+		Request: &http.Request{
+			Header: http.Header(map[string][]string{}),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Config(request): Expected config to be created but error was thrown: %s",
+			err.Error())
+	}
+
+	if cfg.Timeout != 30*time.Second {
+		t.Fatalf("Config(request): Expected request timeout to be %s but got %s",
+			30*time.Second, cfg.Timeout)
+	}
+}
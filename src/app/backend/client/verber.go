@@ -15,15 +15,27 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	restclient "k8s.io/client-go/rest"
 )
 
+// applyPatchType is the content type of server-side apply patches. It is defined here because
+// the vendored apimachinery predates server-side apply.
+const applyPatchType types.PatchType = "application/apply-patch+yaml"
+
+// defaultFieldManager identifies Dashboard as the owner of fields applied with Apply when the
+// caller does not provide its own field manager name.
+const defaultFieldManager = "dashboard"
+
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
 // DELETE, PUT, UPDATE.
 type resourceVerber struct {
@@ -60,6 +72,7 @@ type RESTClient interface {
 	Delete() *restclient.Request
 	Put() *restclient.Request
 	Get() *restclient.Request
+	Patch(pt types.PatchType) *restclient.Request
 }
 
 // NewResourceVerber creates a new resource verber that uses the given client for performing operations.
@@ -133,6 +146,177 @@ func (verber *resourceVerber) Put(kind string, namespaceSet bool, namespace stri
 	return req.Do().Error()
 }
 
+// Apply applies the given manifest to the resource of the given kind in the given namespace
+// with the given name using server-side apply, so that only the fields present in the manifest
+// are updated and ownership conflicts are detected by the apiserver. When force is true
+// conflicts with other field managers are overridden.
+func (verber *resourceVerber) Apply(kind string, namespaceSet bool, namespace string, name string,
+	fieldManager string, force bool, patch []byte) error {
+
+	resourceSpec, ok := api.KindToAPIMapping[kind]
+	if !ok {
+		return fmt.Errorf("Unknown resource kind: %s", kind)
+	}
+
+	if namespaceSet != resourceSpec.Namespaced {
+		if namespaceSet {
+			return fmt.Errorf("Set namespace for not-namespaced resource kind: %s", kind)
+		} else {
+			return fmt.Errorf("Set no namespace for namespaced resource kind: %s", kind)
+		}
+	}
+
+	if len(fieldManager) == 0 {
+		fieldManager = defaultFieldManager
+	}
+
+	client := verber.getRESTClientByType(resourceSpec.ClientType)
+
+	req := client.Patch(applyPatchType).
+		Resource(resourceSpec.Resource).
+		Name(name).
+		Param("fieldManager", fieldManager).
+		Param("force", strconv.FormatBool(force)).
+		Body(patch)
+
+	if resourceSpec.Namespaced {
+		req.Namespace(namespace)
+	}
+
+	return req.Do().Error()
+}
+
+// Patch applies the given JSON merge patch to the resource of the given kind in the given
+// namespace with the given name. A merge patch is used because it works for every resource
+// kind, unlike strategic merge patches.
+func (verber *resourceVerber) Patch(kind string, namespaceSet bool, namespace string, name string,
+	patch []byte) error {
+
+	resourceSpec, ok := api.KindToAPIMapping[kind]
+	if !ok {
+		return fmt.Errorf("Unknown resource kind: %s", kind)
+	}
+
+	if namespaceSet != resourceSpec.Namespaced {
+		if namespaceSet {
+			return fmt.Errorf("Set namespace for not-namespaced resource kind: %s", kind)
+		} else {
+			return fmt.Errorf("Set no namespace for namespaced resource kind: %s", kind)
+		}
+	}
+
+	client := verber.getRESTClientByType(resourceSpec.ClientType)
+
+	req := client.Patch(types.MergePatchType).
+		Resource(resourceSpec.Resource).
+		Name(name).
+		Body(patch)
+
+	if resourceSpec.Namespaced {
+		req.Namespace(namespace)
+	}
+
+	return req.Do().Error()
+}
+
+// jsonPatchOperation is a single operation of a JSON patch (RFC 6902), used to check that a
+// patch is well-formed before sending it to the apiserver.
+type jsonPatchOperation struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+}
+
+// validJSONPatchOps are the operations RFC 6902 defines.
+var validJSONPatchOps = map[string]bool{
+	"add": true, "remove": true, "replace": true, "move": true, "copy": true, "test": true,
+}
+
+// PatchJSON applies the given JSON patch (RFC 6902) to the resource of the given kind in the
+// given namespace with the given name, allowing targeted edits of single fields without a
+// read-modify-write cycle. Malformed patches are rejected before anything is sent.
+func (verber *resourceVerber) PatchJSON(kind string, namespaceSet bool, namespace string,
+	name string, patch []byte) error {
+
+	resourceSpec, ok := api.KindToAPIMapping[kind]
+	if !ok {
+		return fmt.Errorf("Unknown resource kind: %s", kind)
+	}
+
+	if namespaceSet != resourceSpec.Namespaced {
+		if namespaceSet {
+			return fmt.Errorf("Set namespace for not-namespaced resource kind: %s", kind)
+		} else {
+			return fmt.Errorf("Set no namespace for namespaced resource kind: %s", kind)
+		}
+	}
+
+	operations := []jsonPatchOperation{}
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return fmt.Errorf("Malformed JSON patch: %s", err)
+	}
+	if len(operations) == 0 {
+		return fmt.Errorf("Malformed JSON patch: no operations")
+	}
+	for _, operation := range operations {
+		if !validJSONPatchOps[operation.Op] {
+			return fmt.Errorf("Malformed JSON patch: unknown operation %q", operation.Op)
+		}
+		if !strings.HasPrefix(operation.Path, "/") {
+			return fmt.Errorf("Malformed JSON patch: invalid path %q", operation.Path)
+		}
+	}
+
+	client := verber.getRESTClientByType(resourceSpec.ClientType)
+
+	req := client.Patch(types.JSONPatchType).
+		Resource(resourceSpec.Resource).
+		Name(name).
+		Body(patch)
+
+	if resourceSpec.Namespaced {
+		req.Namespace(namespace)
+	}
+
+	return req.Do().Error()
+}
+
+// Count returns the number of resources of the given kind in the given namespace. Only the
+// object metadata is requested from the apiserver, so counting does not transfer full objects.
+// Servers that do not support metadata lists fall back to regular JSON, which is still counted
+// correctly.
+func (verber *resourceVerber) Count(kind string, namespaceSet bool, namespace string) (int, error) {
+	resourceSpec, ok := api.KindToAPIMapping[kind]
+	if !ok {
+		return 0, fmt.Errorf("Unknown resource kind: %s", kind)
+	}
+
+	if namespaceSet && !resourceSpec.Namespaced {
+		return 0, fmt.Errorf("Set namespace for not-namespaced resource kind: %s", kind)
+	}
+
+	client := verber.getRESTClientByType(resourceSpec.ClientType)
+	req := client.Get().Resource(resourceSpec.Resource).
+		SetHeader("Accept", "application/json;as=PartialObjectMetadataList;g=meta.k8s.io;v=v1, application/json")
+
+	if resourceSpec.Namespaced && namespaceSet {
+		req.Namespace(namespace)
+	}
+
+	raw, err := req.Do().Raw()
+	if err != nil {
+		return 0, err
+	}
+
+	list := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return 0, err
+	}
+
+	return len(list.Items), nil
+}
+
 // Get gets the resource of the given kind in the given namespace with the given name.
 func (verber *resourceVerber) Get(kind string, namespaceSet bool, namespace string, name string) (runtime.Object, error) {
 	resourceSpec, ok := api.KindToAPIMapping[kind]
@@ -15,8 +15,11 @@
 package client
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
 	"testing"
 
@@ -25,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	restclient "k8s.io/client-go/rest"
 )
 
@@ -37,6 +41,9 @@ func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
 type FakeRESTClient struct {
 	response *http.Response
 	err      error
+
+	// Request sent by the last Patch call, recorded for assertions.
+	patchRequest *http.Request
 }
 
 func (c *FakeRESTClient) Delete() *restclient.Request {
@@ -62,9 +69,19 @@ func (c *FakeRESTClient) Put() *restclient.Request {
 }
 
 func (c *FakeRESTClient) Get() *restclient.Request {
+	// A base URL is needed for requests that rebuild their path with AbsPath.
+	baseURL := &url.URL{Scheme: "http", Host: "localhost"}
 	return restclient.NewRequest(clientFunc(func(req *http.Request) (*http.Response, error) {
 		return c.response, c.err
-	}), "GET", nil, "/api/v1", restclient.ContentConfig{}, restclient.Serializers{}, nil, nil)
+	}), "GET", baseURL, "/api/v1", restclient.ContentConfig{}, restclient.Serializers{}, nil, nil)
+}
+
+func (c *FakeRESTClient) Patch(pt k8stypes.PatchType) *restclient.Request {
+	return restclient.NewRequest(clientFunc(func(req *http.Request) (*http.Response, error) {
+		c.patchRequest = req
+		return c.response, c.err
+	}), "PATCH", nil, "/api/v1", restclient.ContentConfig{}, restclient.Serializers{}, nil, nil).
+		SetHeader("Content-Type", string(pt))
 }
 
 func TestDeleteShouldPropagateErrorsAndChoseClient(t *testing.T) {
@@ -208,3 +225,126 @@ func TestDeleteShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
 		t.Fatalf("Expected error on verber delete but got %#v", err)
 	}
 }
+
+func TestApplyShouldSendApplyPatch(t *testing.T) {
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+	}}
+	verber := resourceVerber{client: fakeClient}
+
+	err := verber.Apply("service", true, "bar", "baz", "", true, []byte(`{"kind":"Service"}`))
+
+	if err != nil {
+		t.Fatalf("Expected no error on verber apply but got %#v", err)
+	}
+	if fakeClient.patchRequest == nil {
+		t.Fatal("Expected a PATCH request to be sent on verber apply but got none")
+	}
+	if contentType := fakeClient.patchRequest.Header.Get("Content-Type"); contentType != string(applyPatchType) {
+		t.Errorf("Expected content type %#v on verber apply but got %#v", string(applyPatchType),
+			contentType)
+	}
+	query := fakeClient.patchRequest.URL.Query()
+	if fieldManager := query.Get("fieldManager"); fieldManager != defaultFieldManager {
+		t.Errorf("Expected field manager %#v on verber apply but got %#v", defaultFieldManager,
+			fieldManager)
+	}
+	if force := query.Get("force"); force != "true" {
+		t.Errorf("Expected force %#v on verber apply but got %#v", "true", force)
+	}
+}
+
+func TestPatchJSONShouldSendJSONPatch(t *testing.T) {
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+	}}
+	verber := resourceVerber{client: fakeClient}
+
+	err := verber.PatchJSON("service", true, "bar", "baz",
+		[]byte(`[{"op":"replace","path":"/spec/type","value":"NodePort"}]`))
+
+	if err != nil {
+		t.Fatalf("Expected no error on verber json patch but got %#v", err)
+	}
+	if fakeClient.patchRequest == nil {
+		t.Fatal("Expected a PATCH request to be sent on verber json patch but got none")
+	}
+	contentType := fakeClient.patchRequest.Header.Get("Content-Type")
+	if contentType != string(k8stypes.JSONPatchType) {
+		t.Errorf("Expected content type %#v on verber json patch but got %#v",
+			string(k8stypes.JSONPatchType), contentType)
+	}
+}
+
+func TestPatchJSONShouldRejectMalformedPatch(t *testing.T) {
+	fakeClient := &FakeRESTClient{}
+	verber := resourceVerber{client: fakeClient}
+
+	cases := []string{
+		`{"op":"replace"}`,
+		`[]`,
+		`[{"op":"rename","path":"/spec/type"}]`,
+		`[{"op":"replace","path":"spec/type"}]`,
+	}
+
+	for _, c := range cases {
+		err := verber.PatchJSON("service", true, "bar", "baz", []byte(c))
+
+		if err == nil {
+			t.Errorf("Expected error on verber json patch of %#v but got none", c)
+		}
+		if fakeClient.patchRequest != nil {
+			t.Errorf("Expected no PATCH request to be sent for patch %#v", c)
+		}
+	}
+}
+
+func TestCountShouldReturnNumberOfListedItems(t *testing.T) {
+	fakeClient := &FakeRESTClient{response: &http.Response{
+		StatusCode: http.StatusOK,
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(
+			`{"kind":"PodList","items":[{"metadata":{"name":"pod-1"}},{"metadata":{"name":"pod-2"}},{"metadata":{"name":"pod-3"}}]}`))),
+	}}
+	verber := resourceVerber{client: fakeClient}
+
+	count, err := verber.Count("pod", true, "foo")
+
+	if err != nil {
+		t.Fatalf("Expected no error on verber count but got %#v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected count of 3 on verber count but got %d", count)
+	}
+}
+
+func TestCountShouldThrowErrorOnUnknownResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	_, err := verber.Count("foo", true, "bar")
+
+	if !reflect.DeepEqual(err, errors.New("Unknown resource kind: foo")) {
+		t.Fatalf("Expected error on verber count but got %#v", err)
+	}
+}
+
+func TestCountShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	_, err := verber.Count("namespace", true, "bar")
+
+	if !reflect.DeepEqual(err, errors.New("Set namespace for not-namespaced resource kind: namespace")) {
+		t.Fatalf("Expected error on verber count but got %#v", err)
+	}
+}
+
+func TestApplyShouldRespectNotNamespacednessOfResourceKind(t *testing.T) {
+	verber := resourceVerber{client: &FakeRESTClient{}}
+
+	err := verber.Apply("namespace", true, "bar", "baz", "", false, nil)
+
+	if !reflect.DeepEqual(err, errors.New("Set namespace for not-namespaced resource kind: namespace")) {
+		t.Fatalf("Expected error on verber apply but got %#v", err)
+	}
+}
@@ -64,11 +64,18 @@ var (
 	argAuthenticationMode = pflag.StringSlice("authentication-mode", []string{authApi.Token.String()}, "Enables authentication options that will be reflected on login screen. Supported values: token, basic. Default: token."+
 		"Note that basic option should only be used if apiserver has '--authorization-mode=ABAC' and '--basic-auth-file' flags set.")
 	argMetricClientCheckPeriod   = pflag.Int("metric-client-check-period", 30, "Time in seconds that defines how often configured metric client health check should be run. Default: 30 seconds.")
+	argApiRequestTimeout         = pflag.Int("request-timeout", 0, "Time in seconds that defines the timeout applied to every request made to the Kubernetes Apiserver. 0 - no timeout. Default: 0.")
+	argCorsAllowedOrigins        = pflag.StringSlice("cors-allowed-origins", []string{}, "List of origins allowed to access the API with CORS, e.g. 'https://example.com'. When empty no CORS headers are sent and only same-origin requests are allowed. Default: ''.")
+	argGzipLevel                 = pflag.Int("gzip-level", 6, "Gzip compression level (1-9) used for API responses to clients that accept it. 0 - disables compression. Default: 6.")
+	argMaxRequestBody            = pflag.Int("max-request-body", 2*1024*1024, "Maximum size in bytes of a request body accepted by mutating API endpoints. 0 - no limit. Default: 2097152 (2 MiB).")
 	argAutoGenerateCertificates  = pflag.Bool("auto-generate-certificates", false, "When set to true, Dashboard will automatically generate certificates used to serve HTTPS. Default: false.")
 	argEnableInsecureLogin       = pflag.Bool("enable-insecure-login", false, "When enabled, Dashboard login view will also be shown when Dashboard is not served over HTTPS. Default: false.")
 	argSystemBanner              = pflag.String("system-banner", "", "When non-empty displays message to Dashboard users. Accepts simple HTML tags. Default: ''.")
 	argSystemBannerSeverity      = pflag.String("system-banner-severity", "INFO", "Severity of system banner. Should be one of 'INFO|WARNING|ERROR'. Default: 'INFO'.")
 	argDisableSettingsAuthorizer = pflag.Bool("disable-settings-authorizer", false, "When enabled, Dashboard settings page will not require user to be logged in and authorized to access settings page.")
+	argTLSRedirect               = pflag.Bool("tls-redirect", false, "When serving HTTPS, also listen on the insecure port and redirect HTTP requests to the HTTPS endpoint. Default: false.")
+	argStaticDir                 = pflag.String("static-dir", "", "Directory path containing the frontend assets served by Dashboard. When empty, assets are served from the directory of the dashboard binary. Default: ''.")
+	argDefaultLocale             = pflag.String("default-locale", "en", "Locale served when the Accept-Language header and the 'lng' query parameter match none of the available translations. Default: 'en'.")
 )
 
 func main() {
@@ -82,6 +89,11 @@ func main() {
 	// Initializes dashboard arguments holder so we can read them in other packages
 	initArgHolder()
 
+	if !args.Holder.GetAutoGenerateCertificates() &&
+		(args.Holder.GetCertFile() == "") != (args.Holder.GetKeyFile() == "") {
+		log.Fatal("Both --tls-cert-file and --tls-key-file must be set to serve HTTPS.")
+	}
+
 	if args.Holder.GetApiServerHost() != "" {
 		log.Printf("Using apiserver-host location: %s", args.Holder.GetApiServerHost())
 	}
@@ -111,6 +123,9 @@ func main() {
 	integrationManager := integration.NewIntegrationManager(clientManager)
 	integrationManager.Metric().ConfigureHeapster(args.Holder.GetHeapsterHost()).
 		EnableWithRetry(integrationapi.HeapsterIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
+	// Fall back to the resource metrics API (metrics-server) when heapster is not available.
+	integrationManager.Metric().ConfigureMetricsServer().
+		EnableWithRetry(integrationapi.MetricsServerIntegrationID, time.Duration(args.Holder.GetMetricClientCheckPeriod()))
 
 	apiHandler, err := handler.CreateHTTPAPIHandler(
 		integrationManager,
@@ -133,9 +148,8 @@ func main() {
 		}
 		servingCerts = []tls.Certificate{servingCert}
 	} else if args.Holder.GetCertFile() != "" && args.Holder.GetKeyFile() != "" {
-		certFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetCertFile()
-		keyFilePath := args.Holder.GetDefaultCertDir() + string(os.PathSeparator) + args.Holder.GetKeyFile()
-		servingCert, err := tls.LoadX509KeyPair(certFilePath, keyFilePath)
+		servingCert, err := loadServingCertificate(args.Holder.GetDefaultCertDir(),
+			args.Holder.GetCertFile(), args.Holder.GetKeyFile())
 		if err != nil {
 			handleFatalInitError(err)
 		}
@@ -144,10 +158,13 @@ func main() {
 
 	// Run a HTTP server that serves static public files from './public' and handles API calls.
 	// TODO(bryk): Disable directory listing.
-	http.Handle("/", handler.MakeGzipHandler(handler.CreateLocaleHandler()))
+	http.Handle("/", handler.MakeGzipHandler(handler.CreateSpaFallbackHandler(
+		handler.CreateLocaleHandler(*argStaticDir, *argDefaultLocale))))
 	http.Handle("/api/", apiHandler)
 	// TODO(maciaszczykm): Move to /appConfig.json as it was discussed in #640.
-	http.Handle("/api/appConfig.json", handler.AppHandler(handler.ConfigHandler))
+	// The exec terminal and log streaming endpoints are always served by this binary.
+	http.Handle("/api/appConfig.json",
+		handler.CreateConfigHandler(integrationManager.Metric().Client(), true, true))
 	http.Handle("/api/sockjs/", handler.CreateAttachHandler("/api/sockjs"))
 	http.Handle("/metrics", prometheus.Handler())
 
@@ -161,6 +178,12 @@ func main() {
 			TLSConfig: &tls.Config{Certificates: servingCerts},
 		}
 		go func() { log.Fatal(server.ListenAndServeTLS("", "")) }()
+		if *argTLSRedirect {
+			log.Printf("Redirecting HTTP port %d to the HTTPS endpoint", args.Holder.GetInsecurePort())
+			addr := fmt.Sprintf("%s:%d", args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
+			redirectHandler := createTLSRedirectHandler(args.Holder.GetPort())
+			go func() { log.Fatal(http.ListenAndServe(addr, redirectHandler)) }()
+		}
 	} else {
 		log.Printf("Serving insecurely on HTTP port: %d", args.Holder.GetInsecurePort())
 		addr := fmt.Sprintf("%s:%d", args.Holder.GetInsecureBindAddress(), args.Holder.GetInsecurePort())
@@ -169,6 +192,27 @@ func main() {
 	select {}
 }
 
+// loadServingCertificate loads the serving certificate and its private key from the default
+// cert directory.
+func loadServingCertificate(certDir, certFile, keyFile string) (tls.Certificate, error) {
+	certFilePath := certDir + string(os.PathSeparator) + certFile
+	keyFilePath := certDir + string(os.PathSeparator) + keyFile
+	return tls.LoadX509KeyPair(certFilePath, keyFilePath)
+}
+
+// createTLSRedirectHandler returns a handler that redirects every plain HTTP request to the
+// same host and path on the given HTTPS port.
+func createTLSRedirectHandler(httpsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if hostOnly, _, err := net.SplitHostPort(host); err == nil {
+			host = hostOnly
+		}
+		target := fmt.Sprintf("https://%s:%d%s", host, httpsPort, r.RequestURI)
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+	})
+}
+
 func initAuthManager(clientManager clientapi.ClientManager) authApi.AuthManager {
 	insecureClient := clientManager.InsecureClient()
 
@@ -203,6 +247,9 @@ func initArgHolder() {
 	builder.SetPort(*argPort)
 	builder.SetTokenTTL(*argTokenTTL)
 	builder.SetMetricClientCheckPeriod(*argMetricClientCheckPeriod)
+	builder.SetApiRequestTimeout(*argApiRequestTimeout)
+	builder.SetGzipLevel(*argGzipLevel)
+	builder.SetMaxRequestBody(*argMaxRequestBody)
 	builder.SetInsecureBindAddress(*argInsecureBindAddress)
 	builder.SetBindAddress(*argBindAddress)
 	builder.SetDefaultCertDir(*argDefaultCertDir)
@@ -214,6 +261,7 @@ func initArgHolder() {
 	builder.SetSystemBanner(*argSystemBanner)
 	builder.SetSystemBannerSeverity(*argSystemBannerSeverity)
 	builder.SetAuthenticationMode(*argAuthenticationMode)
+	builder.SetCorsAllowedOrigins(*argCorsAllowedOrigins)
 	builder.SetAutoGenerateCertificates(*argAutoGenerateCertificates)
 	builder.SetEnableInsecureLogin(*argEnableInsecureLogin)
 	builder.SetDisableSettingsAuthorizer(*argDisableSettingsAuthorizer)
@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertFiles writes a self-signed certificate and its key into the given directory and
+// returns their file names.
+func writeCertFiles(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := ioutil.WriteFile(filepath.Join(dir, "dashboard.crt"), certPEM, 0644); err != nil {
+		t.Fatalf("failed to write certificate file: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "dashboard.key"), keyPEM, 0600); err != nil {
+		t.Fatalf("failed to write key file: %s", err)
+	}
+	return "dashboard.crt", "dashboard.key"
+}
+
+func TestServeTLSWithCertFiles(t *testing.T) {
+	certDir, err := ioutil.TempDir("", "dashboard-tls")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(certDir)
+	certFile, keyFile := writeCertFiles(t, certDir)
+
+	servingCert, err := loadServingCertificate(certDir, certFile, keyFile)
+	if err != nil {
+		t.Fatalf("loadServingCertificate(%s, %s, %s) returned error: %s", certDir, certFile,
+			keyFile, err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+	server := &http.Server{
+		Handler:   mux,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{servingCert}},
+	}
+	defer server.Close()
+	go server.ServeTLS(listener, "", "")
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+	response, err := client.Get("https://" + listener.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("TLS request to server failed: %s", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("TLS request returned status %d, expected %d", response.StatusCode,
+			http.StatusOK)
+	}
+	if response.TLS == nil {
+		t.Error("response was not served over TLS")
+	}
+}
+
+func TestCreateTLSRedirectHandler(t *testing.T) {
+	handler := createTLSRedirectHandler(8443)
+	request := httptest.NewRequest("GET", "/api/v1/pod?page=1", nil)
+	request.Host = "example.com:9090"
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusMovedPermanently {
+		t.Errorf("createTLSRedirectHandler(8443) returned status %d, expected %d",
+			recorder.Code, http.StatusMovedPermanently)
+	}
+	expectedLocation := "https://example.com:8443/api/v1/pod?page=1"
+	if location := recorder.Header().Get("Location"); location != expectedLocation {
+		t.Errorf("createTLSRedirectHandler(8443) redirected to %s, expected %s", location,
+			expectedLocation)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewNotFound returns an error that maps to HTTP 404. It should be returned when a resource
+// required to handle the request does not exist, so that handlers report the original status
+// instead of a generic 500.
+func NewNotFound(message string) *errors.StatusError {
+	return newStatusError(http.StatusNotFound, metaV1.StatusReasonNotFound, message)
+}
+
+// NewForbidden returns an error that maps to HTTP 403. It should be returned when access to a
+// resource required to handle the request was denied.
+func NewForbidden(message string) *errors.StatusError {
+	return newStatusError(http.StatusForbidden, metaV1.StatusReasonForbidden, message)
+}
+
+// NewTooManyRequests returns an error that maps to HTTP 429. It should be returned when the
+// request is valid but cannot be satisfied right now and retrying later may succeed.
+func NewTooManyRequests(message string) *errors.StatusError {
+	return newStatusError(http.StatusTooManyRequests, metaV1.StatusReasonTooManyRequests, message)
+}
+
+// NewInternal returns an error that maps to HTTP 500. It should be returned for failures that
+// are not caused by the request itself.
+func NewInternal(message string) *errors.StatusError {
+	return newStatusError(http.StatusInternalServerError, metaV1.StatusReasonInternalError, message)
+}
+
+func newStatusError(code int32, reason metaV1.StatusReason, message string) *errors.StatusError {
+	return &errors.StatusError{ErrStatus: metaV1.Status{
+		Status:  metaV1.StatusFailure,
+		Code:    code,
+		Reason:  reason,
+		Message: message,
+	}}
+}
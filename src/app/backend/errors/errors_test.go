@@ -0,0 +1,71 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	restful "github.com/emicklei/go-restful"
+)
+
+func TestSentinelErrorStatusCodes(t *testing.T) {
+	cases := []struct {
+		err          error
+		expectedCode int
+	}{
+		{NewNotFound("deployment not found"), http.StatusNotFound},
+		{NewForbidden("access denied"), http.StatusForbidden},
+		{NewInternal("something went wrong"), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+
+		HandleInternalError(response, c.err)
+		if recorder.Code != c.expectedCode {
+			t.Errorf("HandleInternalError(response, %#v) wrote status %d, expected %d",
+				c.err, recorder.Code, c.expectedCode)
+		}
+	}
+}
+
+func TestSentinelErrorPredicates(t *testing.T) {
+	if !IsNotFoundError(NewNotFound("missing")) {
+		t.Errorf("IsNotFoundError(NewNotFound(...)) == false, expected true")
+	}
+	if !IsForbiddenError(NewForbidden("denied")) {
+		t.Errorf("IsForbiddenError(NewForbidden(...)) == false, expected true")
+	}
+	if IsNotFoundError(NewInternal("broken")) || IsForbiddenError(NewInternal("broken")) {
+		t.Errorf("NewInternal(...) matched a not found or forbidden predicate")
+	}
+}
+
+func TestSentinelErrorCriticality(t *testing.T) {
+	nonCriticalErrors, criticalError := HandleError(NewForbidden("denied"))
+	if criticalError != nil || len(nonCriticalErrors) != 1 {
+		t.Errorf("HandleError(NewForbidden(...)) == %#v, %#v, expected a single non-critical error",
+			nonCriticalErrors, criticalError)
+	}
+
+	nonCriticalErrors, criticalError = HandleError(NewInternal("broken"))
+	if criticalError == nil || len(nonCriticalErrors) != 0 {
+		t.Errorf("HandleError(NewInternal(...)) == %#v, %#v, expected a critical error",
+			nonCriticalErrors, criticalError)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// AllMetricsResponse carries the CPU and memory metrics of the requested resources in a
+// single response, so list views need one round-trip instead of two.
+type AllMetricsResponse struct {
+	// CPU usage metrics of the requested resources.
+	CPUMetrics []metricapi.Metric `json:"cpuMetrics"`
+
+	// Memory usage metrics of the requested resources.
+	MemoryMetrics []metricapi.Metric `json:"memoryMetrics"`
+}
+
+// getAllMetrics downloads CPU and memory metrics for the given selectors in one concurrent
+// pass and groups the results by metric name.
+func getAllMetrics(metricClient metricapi.MetricClient,
+	selectors []metricapi.ResourceSelector) (*AllMetricsResponse, error) {
+	if metricClient == nil {
+		return nil, fmt.Errorf("No metric client is available.")
+	}
+
+	// DownloadMetrics starts the download of every metric before the first blocking read, so
+	// both metrics are fetched concurrently.
+	metrics, err := metricClient.DownloadMetrics(selectors,
+		[]string{metricapi.CpuUsage, metricapi.MemoryUsage}, metricapi.NoResourceCache).
+		GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AllMetricsResponse{
+		CPUMetrics:    make([]metricapi.Metric, 0),
+		MemoryMetrics: make([]metricapi.Metric, 0),
+	}
+	for _, metric := range metrics {
+		switch metric.MetricName {
+		case metricapi.CpuUsage:
+			response.CPUMetrics = append(response.CPUMetrics, metric)
+		case metricapi.MemoryUsage:
+			response.MemoryMetrics = append(response.MemoryMetrics, metric)
+		}
+	}
+	return response, nil
+}
+
+// parseMetricResourceSelectors builds a metric selector for every comma separated resource
+// name of the given kind.
+func parseMetricResourceSelectors(kind, namespace, names string) []metricapi.ResourceSelector {
+	selectors := make([]metricapi.ResourceSelector, 0)
+	for _, name := range strings.Split(names, ",") {
+		if name == "" {
+			continue
+		}
+		selectors = append(selectors, metricapi.ResourceSelector{
+			Namespace:    namespace,
+			ResourceType: api.ResourceKind(kind),
+			ResourceName: name,
+		})
+	}
+	return selectors
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// recordingMetricClient resolves every requested metric with a single data point and records
+// the metric names it was asked for.
+type recordingMetricClient struct {
+	fakeMetricClient
+	downloadedMetricNames []string
+}
+
+func (self *recordingMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	self.downloadedMetricNames = append(self.downloadedMetricNames, metricNames...)
+
+	result := metricapi.MetricPromises{}
+	for _, metricName := range metricNames {
+		promises := metricapi.NewMetricPromises(1)
+		promises.PutMetrics([]metricapi.Metric{{MetricName: metricName}}, nil)
+		result = append(result, promises...)
+	}
+	return result
+}
+
+func TestGetAllMetrics(t *testing.T) {
+	metricClient := &recordingMetricClient{}
+	selectors := parseMetricResourceSelectors("pod", "ns-1", "pod-1")
+
+	actual, err := getAllMetrics(metricClient, selectors)
+	if err != nil {
+		t.Fatalf("getAllMetrics(metricClient, selectors) returned error: %s", err)
+	}
+
+	expectedNames := []string{metricapi.CpuUsage, metricapi.MemoryUsage}
+	if !reflect.DeepEqual(metricClient.downloadedMetricNames, expectedNames) {
+		t.Errorf("getAllMetrics(metricClient, selectors) downloaded %#v, expected %#v",
+			metricClient.downloadedMetricNames, expectedNames)
+	}
+
+	expected := &AllMetricsResponse{
+		CPUMetrics:    []metricapi.Metric{{MetricName: metricapi.CpuUsage}},
+		MemoryMetrics: []metricapi.Metric{{MetricName: metricapi.MemoryUsage}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getAllMetrics(metricClient, selectors) == \ngot %#v, \nexpected %#v", actual,
+			expected)
+	}
+}
@@ -15,19 +15,26 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/emicklei/go-restful/swagger"
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
+	"github.com/kubernetes/dashboard/src/app/backend/client"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/integration"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/analysis"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/cluster"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/config"
@@ -38,6 +45,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/daemonset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/deployment"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/describe"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/discovery"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/endpoint"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
@@ -46,19 +54,24 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/job"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	ns "github.com/kubernetes/dashboard/src/app/backend/resource/namespace"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/networkpolicy"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/node"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/overview"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolume"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/poddisruptionbudget"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/rbacrolebindings"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/rbacroles"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicaset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicationcontroller"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/resourcequota"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/secret"
 	resourceService "github.com/kubernetes/dashboard/src/app/backend/resource/service"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/serviceaccount"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/statefulset"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/storageclass"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/webhook"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/workload"
 	"github.com/kubernetes/dashboard/src/app/backend/scaling"
 	"github.com/kubernetes/dashboard/src/app/backend/search"
@@ -66,16 +79,20 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/systembanner"
 	"github.com/kubernetes/dashboard/src/app/backend/validation"
 	"golang.org/x/net/xsrftoken"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
 const (
 	// RequestLogString is a template for request log message.
-	RequestLogString = "[%s] Incoming %s %s %s request from %s: %s"
+	RequestLogString = "[%s] [%s] Incoming %s %s %s request from %s: %s"
 
 	// ResponseLogString is a template for response log message.
-	ResponseLogString = "[%s] Outcoming response to %s with %d status code"
+	ResponseLogString = "[%s] [%s] Outcoming response to %s with %d status code"
 )
 
 // APIHandler is a representation of API handler. Structure contains clientapi, Heapster clientapi and clientapi configuration.
@@ -99,7 +116,19 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	http.Handler, error) {
 	apiHandler := APIHandler{iManager: iManager, cManager: cManager}
 	wsContainer := restful.NewContainer()
-	wsContainer.EnableContentEncoding(true)
+	wsContainer.Filter(gzipFilter)
+
+	// CORS is disabled unless allowed origins were explicitly configured.
+	if corsAllowedOrigins := args.Holder.GetCorsAllowedOrigins(); len(corsAllowedOrigins) > 0 {
+		cors := restful.CrossOriginResourceSharing{
+			AllowedDomains: corsAllowedOrigins,
+			AllowedHeaders: []string{"Content-Type", "Accept", "Authorization", client.JWETokenHeader},
+			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
+			Container:      wsContainer,
+		}
+		wsContainer.Filter(cors.Filter)
+		wsContainer.Filter(wsContainer.OPTIONSFilter)
+	}
 
 	apiV1Ws := new(restful.WebService)
 
@@ -200,6 +229,14 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/cluster").
 			To(apiHandler.handleGetCluster).
 			Writes(cluster.Cluster{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/cluster/info").
+			To(apiHandler.handleGetClusterInfo).
+			Writes(ClusterInfo{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/cluster/capacity").
+			To(apiHandler.handleGetClusterCapacity).
+			Writes(cluster.ClusterCapacity{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/discovery").
@@ -244,6 +281,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/pod").
 			To(apiHandler.handleGetPods).
 			Writes(pod.PodList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/search").
+			To(apiHandler.handleSearchPodsByImage).
+			Writes(pod.PodSearchResult{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/pod/{namespace}").
 			To(apiHandler.handleGetPods).
@@ -260,6 +301,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/pod/{namespace}/{pod}/event").
 			To(apiHandler.handleGetPodEvents).
 			Writes(common.EventList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/scheduling").
+			To(apiHandler.handleGetPodScheduling).
+			Writes(pod.PodScheduling{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/pod/{namespace}/{pod}/shell/{container}").
 			To(apiHandler.handleExecShell).
@@ -268,7 +313,35 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/pod/{namespace}/{pod}/persistentvolumeclaim").
 			To(apiHandler.handleGetPodPersistentVolumeClaims).
 			Writes(persistentvolumeclaim.PersistentVolumeClaimList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/container/{container}/env").
+			To(apiHandler.handleGetContainerEnv).
+			Writes(container.ContainerEnv{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/container/{container}/laststate").
+			To(apiHandler.handleGetContainerLastState).
+			Writes(container.ContainerLastState{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/metrics/memory").
+			To(apiHandler.handleGetPodMemoryBreakdown).
+			Writes(pod.PodMemoryBreakdown{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/metrics/live").
+			To(apiHandler.handleLivePodMetrics))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/pod/{namespace}/{pod}/evict").
+			To(apiHandler.handleEvictPod))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/custommetrics/{namespace}/{kind}/{name}/{metric}").
+			To(apiHandler.handleGetCustomMetric).
+			Writes(client.MetricValue{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.POST("/deployment/{namespace}").
+			To(apiHandler.handleCreateDeployment).
+			Reads(deployment.DeploymentSpec{}).
+			Writes(deployment.DeploymentSpec{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/deployment").
 			To(apiHandler.handleGetDeployments).
@@ -289,6 +362,36 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/deployment/{namespace}/{deployment}/oldreplicaset").
 			To(apiHandler.handleGetDeploymentOldReplicaSets).
 			Writes(replicaset.ReplicaSetList{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/deployment/{namespace}/{deployment}/hibernate").
+			To(apiHandler.handleHibernateDeployment))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/deployment/{namespace}/{deployment}/wake").
+			To(apiHandler.handleWakeDeployment))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/deployment/{namespace}/{deployment}/bundle").
+			To(apiHandler.handleGetDeploymentBundle))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/deployment/{namespace}/{deployment}/drift").
+			To(apiHandler.handleGetDeploymentDrift).
+			Writes(deployment.DeploymentDrift{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/deployment/{namespace}/{deployment}/topology").
+			To(apiHandler.handleGetDeploymentTopology).
+			Writes(deployment.DeploymentTopology{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/deployment/{namespace}/{deployment}/image").
+			To(apiHandler.handleUpdateDeploymentContainerImage).
+			Reads(deployment.UpdateContainerImageSpec{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/deployment/{namespace}/{deployment}/env").
+			To(apiHandler.handleUpdateDeploymentEnvironmentVariables).
+			Reads(deployment.UpdateEnvSpec{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/deployment/diff").
+			To(apiHandler.handleDiffDeployments).
+			Reads(deployment.DeploymentDiffSpec{}).
+			Writes(deployment.DeploymentDiff{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/scale/{kind}/{namespace}/{name}/").
@@ -311,6 +414,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/daemonset/{namespace}/{daemonSet}").
 			To(apiHandler.handleGetDaemonSetDetail).
 			Writes(daemonset.DaemonSetDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.DELETE("/daemonset/{namespace}/{daemonSet}").
+			To(apiHandler.handleDeleteDaemonSet).
+			Writes(daemonset.DaemonSetDeletion{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/daemonset/{namespace}/{daemonSet}/pod").
 			To(apiHandler.handleGetDaemonSetPods).
@@ -357,6 +464,9 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/job/{namespace}/{name}/event").
 			To(apiHandler.handleGetJobEvents).
 			Writes(common.EventList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/job/{namespace}/{name}/status/watch").
+			To(apiHandler.handleWatchJobStatus))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/cronjob").
@@ -384,6 +494,11 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleCreateNamespace).
 			Reads(ns.NamespaceSpec{}).
 			Writes(ns.NamespaceSpec{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/namespace/batch").
+			To(apiHandler.handleCreateNamespaceBatch).
+			Reads(ns.NamespaceBatchSpec{}).
+			Writes(ns.NamespaceBatchResultList{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/namespace").
 			To(apiHandler.handleGetNamespaces).
@@ -396,6 +511,18 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/namespace/{name}/event").
 			To(apiHandler.handleGetNamespaceEvents).
 			Writes(common.EventList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/namespace/{name}/heatmap").
+			To(apiHandler.handleGetNamespaceHeatmap).
+			Writes(pod.NamespaceHeatmap{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/event/watch").
+			To(apiHandler.handleWatchEvents))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/event/{namespace}/{kind}/{name}").
+			To(apiHandler.handleGetInvolvedObjectEvents).
+			Writes(common.EventList{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/secret").
@@ -405,10 +532,35 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/secret/{namespace}").
 			To(apiHandler.handleGetSecretList).
 			Writes(secret.SecretList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/secret/{namespace}/certificates").
+			To(apiHandler.handleGetSecretCertificateList).
+			Writes(secret.SecretCertificateList{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/secret/{namespace}/{name}").
 			To(apiHandler.handleGetSecretDetail).
 			Writes(secret.SecretDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/secret/{namespace}/{name}/usage").
+			To(apiHandler.handleGetSecretUsage).
+			Writes(secret.SecretUsage{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/secret/{namespace}/{name}/references").
+			To(apiHandler.handleGetSecretReferences).
+			Writes(secret.SecretReferences{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/serviceaccount/{namespace}").
+			To(apiHandler.handleGetServiceAccountList).
+			Writes(serviceaccount.ServiceAccountList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/serviceaccount/{namespace}/{name}/bindings").
+			To(apiHandler.handleGetServiceAccountBindings).
+			Writes(rbacrolebindings.RbacRoleBindingList{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/secret/{namespace}/{name}/diff").
+			To(apiHandler.handleDiffSecret).
+			Writes(common.KeyDiff{}))
 	apiV1Ws.Route(
 		apiV1Ws.POST("/secret").
 			To(apiHandler.handleCreateImagePullSecret).
@@ -427,6 +579,14 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/configmap/{namespace}/{configmap}").
 			To(apiHandler.handleGetConfigMapDetail).
 			Writes(configmap.ConfigMapDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/configmap/{namespace}/{configmap}/usage").
+			To(apiHandler.handleGetConfigMapUsage).
+			Writes(configmap.ConfigMapUsage{}))
+	apiV1Ws.Route(
+		apiV1Ws.POST("/configmap/{namespace}/{configmap}/diff").
+			To(apiHandler.handleDiffConfigMap).
+			Writes(common.KeyDiff{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/service").
@@ -440,6 +600,11 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/service/{namespace}/{service}").
 			To(apiHandler.handleGetServiceDetail).
 			Writes(resourceService.ServiceDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.PUT("/service/{namespace}/{service}/type").
+			To(apiHandler.handleUpdateServiceType).
+			Reads(resourceService.ServiceTypeUpdateSpec{}).
+			Writes(resourceService.Service{}))
 	apiV1Ws.Route(
 		apiV1Ws.GET("/service/{namespace}/{service}/pod").
 			To(apiHandler.handleGetServicePods).
@@ -494,8 +659,24 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.GET("/node/{name}/pod").
 			To(apiHandler.handleGetNodePods).
-			Writes(pod.PodList{}))
+			Writes(node.NodePodList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/node/{name}/utilization").
+			To(apiHandler.handleGetNodeUtilization).
+			Writes(node.NodeUtilization{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.POST("/_raw/resourcequota/namespace/{namespace}").
+			To(apiHandler.handleCreateResourceQuota).
+			Reads(resourcequota.ResourceQuotaSpec{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/count").
+			To(apiHandler.handleCountResources).
+			Writes(clientapi.ResourceCount{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/namespace/{namespace}/count").
+			To(apiHandler.handleCountResources).
+			Writes(clientapi.ResourceCount{}))
 	apiV1Ws.Route(
 		apiV1Ws.DELETE("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handleDeleteResource))
@@ -505,6 +686,21 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/namespace/{namespace}/name/{name}").
 			To(apiHandler.handlePutResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}").
+			To(apiHandler.handleApplyResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}").
+			Consumes(string(types.JSONPatchType)).
+			To(apiHandler.handleJSONPatchResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/namespace/{namespace}/name/{name}/metadata").
+			To(apiHandler.handlePatchResourceMetadata).
+			Reads(MetadataUpdateSpec{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/_raw/{kind}/namespace/{namespace}/name/{name}/describe").
+			To(apiHandler.handleDescribeResource).
+			Writes(describe.Description{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.DELETE("/_raw/{kind}/name/{name}").
@@ -515,6 +711,17 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 	apiV1Ws.Route(
 		apiV1Ws.PUT("/_raw/{kind}/name/{name}").
 			To(apiHandler.handlePutResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/name/{name}").
+			To(apiHandler.handleApplyResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/name/{name}").
+			Consumes(string(types.JSONPatchType)).
+			To(apiHandler.handleJSONPatchResource))
+	apiV1Ws.Route(
+		apiV1Ws.PATCH("/_raw/{kind}/name/{name}/metadata").
+			To(apiHandler.handlePatchResourceMetadata).
+			Reads(MetadataUpdateSpec{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/rbac/role").
@@ -554,6 +761,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/persistentvolumeclaim/{namespace}/{name}").
 			To(apiHandler.handleGetPersistentVolumeClaimDetail).
 			Writes(persistentvolumeclaim.PersistentVolumeClaimDetail{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/persistentvolumeclaim/{namespace}/{name}/event").
+			To(apiHandler.handleGetPersistentVolumeClaimEvents).
+			Writes(common.EventList{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/storageclass").
@@ -569,6 +780,42 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleGetStorageClassPersistentVolumes).
 			Writes(persistentvolume.PersistentVolumeList{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.GET("/webhook").
+			To(apiHandler.handleGetAdmissionWebhookList).
+			Writes(webhook.AdmissionWebhookList{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/metrics/{kind}/all").
+			To(apiHandler.handleGetAllMetrics).
+			Writes(AllMetricsResponse{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/networkpolicy").
+			To(apiHandler.handleGetNetworkPolicyList).
+			Writes(networkpolicy.NetworkPolicyList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/networkpolicy/{namespace}").
+			To(apiHandler.handleGetNetworkPolicyList).
+			Writes(networkpolicy.NetworkPolicyList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/networkpolicy/{namespace}/{name}").
+			To(apiHandler.handleGetNetworkPolicyDetail).
+			Writes(networkpolicy.NetworkPolicyDetail{}))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/poddisruptionbudget").
+			To(apiHandler.handleGetPodDisruptionBudgetList).
+			Writes(poddisruptionbudget.PodDisruptionBudgetList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/poddisruptionbudget/{namespace}").
+			To(apiHandler.handleGetPodDisruptionBudgetList).
+			Writes(poddisruptionbudget.PodDisruptionBudgetList{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/poddisruptionbudget/{namespace}/{name}").
+			To(apiHandler.handleGetPodDisruptionBudgetDetail).
+			Writes(poddisruptionbudget.PodDisruptionBudgetDetail{}))
+
 	apiV1Ws.Route(
 		apiV1Ws.GET("/search").
 			To(apiHandler.handleSearch).
@@ -590,6 +837,10 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 		apiV1Ws.GET("/log/{namespace}/{pod}/{container}").
 			To(apiHandler.handleLogs).
 			Writes(logs.LogDetails{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/pod/{namespace}/{pod}/log/_all").
+			To(apiHandler.handleAllContainersLogs).
+			Writes(logs.LogDetails{}))
 
 	apiV1Ws.Route(
 		apiV1Ws.GET("/log/file/{namespace}/{pod}/{container}").
@@ -606,6 +857,27 @@ func CreateHTTPAPIHandler(iManager integration.IntegrationManager, cManager clie
 			To(apiHandler.handleOverview).
 			Writes(overview.Overview{}))
 
+	apiV1Ws.Route(
+		apiV1Ws.GET("/proxy/{service}/{path:*}").
+			To(apiHandler.handleServiceProxy))
+
+	apiV1Ws.Route(
+		apiV1Ws.GET("/analysis/dangling/{namespace}").
+			To(apiHandler.handleGetDanglingResources).
+			Writes(analysis.DanglingResources{}))
+	apiV1Ws.Route(
+		apiV1Ws.GET("/analysis/restarts/{namespace}").
+			To(apiHandler.handleGetRestartAnalysis).
+			Writes(analysis.RestartAnalysis{}))
+
+	// Expose a Swagger spec of the API, built from the route metadata declared on each
+	// web service, so clients can generate typed SDKs.
+	swagger.RegisterSwaggerService(swagger.Config{
+		WebServices: wsContainer.RegisteredWebServices(),
+		ApiPath:     "/apidocs.json",
+		ApiVersion:  "v1",
+	}, wsContainer)
+
 	return wsContainer, nil
 }
 
@@ -730,6 +1002,7 @@ func (apiHandler *APIHandler) handleGetStatefulSetEvents(request *restful.Reques
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("statefulset")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -791,6 +1064,29 @@ func (apiHandler *APIHandler) handleGetServiceDetail(request *restful.Request, r
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleUpdateServiceType(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("service")
+	spec := new(resourceService.ServiceTypeUpdateSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := resourceService.UpdateServiceType(k8sClient, namespace, name, spec)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetIngressDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -825,6 +1121,62 @@ func (apiHandler *APIHandler) handleGetIngressList(request *restful.Request, res
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetDanglingResources(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	result, err := analysis.FindDangling(k8sClient, common.NewSameNamespaceQuery(namespace))
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetRestartAnalysis(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	result, err := analysis.GetRestartAnalysis(k8sClient, common.NewSameNamespaceQuery(namespace))
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleServiceProxy(request *restful.Request, response *restful.Response) {
+	service := request.PathParameter("service")
+	if !isServiceProxied(service) {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(http.StatusForbidden,
+			fmt.Sprintf("Service %s is not on the proxy whitelist\n", service))
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	data, err := proxyServiceRequest(k8sClient.CoreV1().RESTClient(), service,
+		request.PathParameter("path"))
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.Write(data)
+}
+
 func (apiHandler *APIHandler) handleGetServicePods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -878,6 +1230,37 @@ func (apiHandler *APIHandler) handleGetCluster(request *restful.Request, respons
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetClusterInfo(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := getClusterInfo(k8sClient, apiHandler.iManager.Metric().Client(),
+		args.Holder.GetApiServerHost())
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetClusterCapacity(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := cluster.GetClusterCapacity(k8sClient)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetNodeDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -906,6 +1289,7 @@ func (apiHandler *APIHandler) handleGetNodeEvents(request *restful.Request, resp
 	name := request.PathParameter("name")
 	dataSelect := parseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetNodeEvents(k8sClient, dataSelect, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -932,6 +1316,22 @@ func (apiHandler *APIHandler) handleGetNodePods(request *restful.Request, respon
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetNodeUtilization(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := node.GetNodeUtilization(k8sClient, apiHandler.iManager.Metric().Client(), name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleDeploy(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -944,13 +1344,100 @@ func (apiHandler *APIHandler) handleDeploy(request *restful.Request, response *r
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	if err := deployment.DeployApp(appDeploymentSpec, k8sClient); err != nil {
+	dryRun := request.QueryParameter("dryRun") == "true"
+	if err := deployment.DeployApp(appDeploymentSpec, k8sClient, dryRun); err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
+	if dryRun {
+		response.WriteHeaderAndEntity(http.StatusOK, appDeploymentSpec)
+		return
+	}
 	response.WriteHeaderAndEntity(http.StatusCreated, appDeploymentSpec)
 }
 
+func (apiHandler *APIHandler) handleCreateDeployment(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	deploymentSpec := new(deployment.DeploymentSpec)
+	if err := request.ReadEntity(deploymentSpec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	deploymentSpec.Namespace = request.PathParameter("namespace")
+	if err := deployment.CreateDeployment(deploymentSpec, k8sClient); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, deploymentSpec)
+}
+
+func (apiHandler *APIHandler) handleUpdateDeploymentContainerImage(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	spec := new(deployment.UpdateContainerImageSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	if err := deployment.UpdateContainerImage(k8sClient, namespace, name, spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, spec)
+}
+
+func (apiHandler *APIHandler) handleUpdateDeploymentEnvironmentVariables(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	spec := new(deployment.UpdateEnvSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	if err := deployment.UpdateEnvironmentVariables(k8sClient, namespace, name, spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, spec)
+}
+
+func (apiHandler *APIHandler) handleDiffDeployments(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(deployment.DeploymentDiffSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	result, err := deployment.DiffDeployments(k8sClient, spec)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleScaleResource(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1001,7 +1488,8 @@ func (apiHandler *APIHandler) handleDeployFromFile(request *restful.Request, res
 		return
 	}
 
-	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec)
+	dryRun := request.QueryParameter("dryRun") == "true"
+	isDeployed, err := deployment.DeployAppFromFile(cfg, deploymentSpec, dryRun)
 	if !isDeployed {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1012,7 +1500,12 @@ func (apiHandler *APIHandler) handleDeployFromFile(request *restful.Request, res
 		errorMessage = err.Error()
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, deployment.AppDeploymentFromFileResponse{
+	statusCode := http.StatusCreated
+	if dryRun {
+		statusCode = http.StatusOK
+	}
+
+	response.WriteHeaderAndEntity(statusCode, deployment.AppDeploymentFromFileResponse{
 		Name:    deploymentSpec.Name,
 		Content: deploymentSpec.Content,
 		Error:   errorMessage,
@@ -1097,7 +1590,10 @@ func (apiHandler *APIHandler) handleGetWorkloads(request *restful.Request, respo
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.NoMetrics
-	result, err := workload.GetWorkloads(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+	kinds := workload.ParseWorkloadKinds(request.QueryParameter("kinds"))
+	labelSelector := request.QueryParameter("labelSelector")
+	result, err := workload.GetWorkloads(k8sClient, apiHandler.iManager.Metric().Client(), namespace,
+		dataSelect, kinds, labelSelector)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1264,6 +1760,7 @@ func (apiHandler *APIHandler) handleGetReplicaSetEvents(request *restful.Request
 	name := request.PathParameter("replicaSet")
 	dataSelect := parseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1285,6 +1782,7 @@ func (apiHandler *APIHandler) handleGetPodEvents(request *restful.Request, respo
 	name := request.PathParameter("pod")
 	dataSelect := parseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := pod.GetEventsForPod(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1293,21 +1791,49 @@ func (apiHandler *APIHandler) handleGetPodEvents(request *restful.Request, respo
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles execute shell API call
-func (apiHandler *APIHandler) handleExecShell(request *restful.Request, response *restful.Response) {
-	sessionId, err := genTerminalSessionId()
+func (apiHandler *APIHandler) handleGetPodMemoryBreakdown(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	result, err := pod.GetPodMemoryBreakdown(apiHandler.iManager.Metric().Client(), namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
 
+func (apiHandler *APIHandler) handleGetPodScheduling(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	cfg, err := apiHandler.cManager.Config(request)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	result, err := pod.GetPodScheduling(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles execute shell API call
+func (apiHandler *APIHandler) handleExecShell(request *restful.Request, response *restful.Response) {
+	sessionId, err := genTerminalSessionId()
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	cfg, err := apiHandler.cManager.Config(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1368,6 +1894,7 @@ func (apiHandler *APIHandler) handleGetDeploymentEvents(request *restful.Request
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("deployment")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1395,6 +1922,92 @@ func (apiHandler *APIHandler) handleGetDeploymentOldReplicaSets(request *restful
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetDeploymentTopology(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	result, err := deployment.GetDeploymentTopology(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleHibernateDeployment(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	if err := deployment.HibernateDeployment(k8sClient, namespace, name); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func (apiHandler *APIHandler) handleWakeDeployment(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	if err := deployment.WakeDeployment(k8sClient, namespace, name); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentDrift(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	result, err := deployment.GetDeploymentDrift(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetDeploymentBundle(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	withSecrets := request.QueryParameter("withSecrets") == "true"
+	bundle, err := deployment.GetDeploymentBundle(k8sClient, namespace, name, withSecrets)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.AddHeader("Content-Type", "text/yaml")
+	response.WriteHeader(http.StatusOK)
+	response.Write([]byte(bundle))
+}
+
 func (apiHandler *APIHandler) handleGetPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1405,7 +2018,50 @@ func (apiHandler *APIHandler) handleGetPods(request *restful.Request, response *
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parseDataSelectPathParameter(request)
 	dataSelect.MetricQuery = dataselect.StandardMetrics // download standard metrics - cpu, and memory - by default
-	result, err := pod.GetPodList(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+
+	options := metaV1.ListOptions{}
+	if fieldSelector := request.QueryParameter("fieldSelector"); len(fieldSelector) > 0 {
+		selector, err := fields.ParseSelector(fieldSelector)
+		if err != nil {
+			response.AddHeader("Content-Type", "text/plain")
+			response.WriteErrorString(http.StatusBadRequest, err.Error()+"\n")
+			return
+		}
+		options.FieldSelector = selector.String()
+	}
+
+	if request.QueryParameter("groupBy") == "owner" {
+		result, err := pod.GetPodListGroupedByOwner(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect)
+		if err != nil {
+			kdErrors.HandleInternalError(response, err)
+			return
+		}
+		response.WriteHeaderAndEntity(http.StatusOK, result)
+		return
+	}
+
+	result, err := pod.GetPodListWithOptions(k8sClient, apiHandler.iManager.Metric().Client(), namespace, dataSelect, options)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleSearchPodsByImage(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	// The search is cluster-wide unless a namespace query parameter is given.
+	nsQuery := common.NewNamespaceQuery(nil)
+	if namespace := request.QueryParameter("namespace"); len(namespace) > 0 {
+		nsQuery = common.NewNamespaceQuery([]string{namespace})
+	}
+
+	result, err := pod.SearchPodsByImage(k8sClient, nsQuery, request.QueryParameter("image"))
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1489,6 +2145,49 @@ func (apiHandler *APIHandler) handleGetResource(request *restful.Request, respon
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleDescribeResource(request *restful.Request, response *restful.Response) {
+	verber, err := apiHandler.cManager.VerberClient(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	eventCache := event.NewCache(k8sClient, namespace)
+	result, err := describe.GetDescription(verber, k8sClient, eventCache, kind, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleCountResources(request *restful.Request, response *restful.Response) {
+	verber, err := apiHandler.cManager.VerberClient(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	count, err := verber.Count(kind, ok, namespace)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, clientapi.ResourceCount{Count: count})
+}
+
 func (apiHandler *APIHandler) handlePutResource(
 	request *restful.Request, response *restful.Response) {
 	verber, err := apiHandler.cManager.VerberClient(request)
@@ -1514,6 +2213,110 @@ func (apiHandler *APIHandler) handlePutResource(
 	response.WriteHeader(http.StatusCreated)
 }
 
+func (apiHandler *APIHandler) handleApplyResource(
+	request *restful.Request, response *restful.Response) {
+	verber, err := apiHandler.cManager.VerberClient(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	fieldManager := request.QueryParameter("fieldManager")
+	force := request.QueryParameter("force") == "true"
+	applySpec := &runtime.Unknown{}
+	if err := request.ReadEntity(applySpec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := verber.Apply(kind, ok, namespace, name, fieldManager, force, applySpec.Raw); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+func (apiHandler *APIHandler) handleJSONPatchResource(
+	request *restful.Request, response *restful.Response) {
+	verber, err := apiHandler.cManager.VerberClient(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	patch, err := ioutil.ReadAll(request.Request.Body)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	if err := verber.PatchJSON(kind, ok, namespace, name, patch); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+func (apiHandler *APIHandler) handlePatchResourceMetadata(
+	request *restful.Request, response *restful.Response) {
+	verber, err := apiHandler.cManager.VerberClient(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	kind := request.PathParameter("kind")
+	namespace, ok := request.PathParameters()["namespace"]
+	name := request.PathParameter("name")
+	spec := new(MetadataUpdateSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	patch, err := spec.toMergePatch()
+	if err != nil {
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(http.StatusBadRequest, err.Error()+"\n")
+		return
+	}
+
+	if err := verber.Patch(kind, ok, namespace, name, patch); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+func (apiHandler *APIHandler) handleGetCustomMetric(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	kind := request.PathParameter("kind")
+	name := request.PathParameter("name")
+	metric := request.PathParameter("metric")
+	customMetricsClient := client.NewCustomMetricsClient(k8sClient.CoreV1().RESTClient())
+	result, err := customMetricsClient.GetMetric(namespace, kind, name, metric)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleDeleteResource(
 	request *restful.Request, response *restful.Response) {
 	verber, err := apiHandler.cManager.VerberClient(request)
@@ -1565,22 +2368,320 @@ func (apiHandler *APIHandler) handleCreateNamespace(request *restful.Request, re
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
+	if err := ns.CreateNamespace(namespaceSpec, k8sClient); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+}
+
+func (apiHandler *APIHandler) handleCreateNamespaceBatch(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	batchSpec := new(ns.NamespaceBatchSpec)
+	if err := request.ReadEntity(batchSpec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	result := ns.CreateNamespaceBatch(batchSpec, k8sClient)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleCreateResourceQuota(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	resourceQuotaSpec := new(resourcequota.ResourceQuotaSpec)
+	if err := request.ReadEntity(resourceQuotaSpec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	if err := resourcequota.CreateResourceQuota(resourceQuotaSpec, namespace, k8sClient); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, resourceQuotaSpec)
+}
+
+func (apiHandler *APIHandler) handleGetNamespaces(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parseDataSelectPathParameter(request)
+	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNamespaceDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := ns.GetNamespaceDetail(k8sClient, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNamespaceEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
+	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetAllMetrics(request *restful.Request, response *restful.Response) {
+	kind := request.PathParameter("kind")
+	namespace := request.QueryParameter("namespace")
+	names := request.QueryParameter("name")
+
+	selectors := parseMetricResourceSelectors(kind, namespace, names)
+	result, err := getAllMetrics(apiHandler.iManager.Metric().Client(), selectors)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNamespaceHeatmap(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	name := request.PathParameter("name")
+	result, err := pod.GetNamespaceHeatmap(k8sClient, apiHandler.iManager.Metric().Client(), name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetInvolvedObjectEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	kind := request.PathParameter("kind")
+	name := request.PathParameter("name")
+	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
+	result, err := event.GetInvolvedObjectEvents(k8sClient, dataSelect, namespace, kind, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	spec := new(secret.ImagePullSecretSpec)
+	if err := request.ReadEntity(spec); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	result, err := secret.CreateSecret(k8sClient, spec)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, result)
+}
+
+func (apiHandler *APIHandler) handleGetSecretDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetSecretCertificateList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	result, err := secret.GetSecretCertificateList(k8sClient, namespace)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetSecretUsage(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := secret.GetSecretUsage(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetSecretReferences(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := secret.GetSecretReferences(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleDiffSecret(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	proposed := new(v1.Secret)
+	if err := request.ReadEntity(proposed); err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := secret.GetSecretDiff(k8sClient, namespace, name, proposed)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetSecretList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parseDataSelectPathParameter(request)
+	namespace := parseNamespacePathParameter(request)
+	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetServiceAccountList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	dataSelect := parseDataSelectPathParameter(request)
+	namespace := parseNamespacePathParameter(request)
+	result, err := serviceaccount.GetServiceAccountList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetServiceAccountBindings(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := serviceaccount.GetServiceAccountBindings(k8sClient, namespace, name)
+	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetNamespaces(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
+	namespace := parseNamespacePathParameter(request)
 	dataSelect := parseDataSelectPathParameter(request)
-	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1588,15 +2689,16 @@ func (apiHandler *APIHandler) handleGetNamespaces(request *restful.Request, resp
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetNamespaceDetail(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetConfigMapDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("name")
-	result, err := ns.GetNamespaceDetail(k8sClient, name)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("configmap")
+	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1604,16 +2706,16 @@ func (apiHandler *APIHandler) handleGetNamespaceDetail(request *restful.Request,
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetNamespaceEvents(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetConfigMapUsage(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("name")
-	dataSelect := parseDataSelectPathParameter(request)
-	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("configmap")
+	result, err := configmap.GetConfigMapUsage(k8sClient, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1621,36 +2723,38 @@ func (apiHandler *APIHandler) handleGetNamespaceEvents(request *restful.Request,
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleDiffConfigMap(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	spec := new(secret.ImagePullSecretSpec)
-	if err := request.ReadEntity(spec); err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("configmap")
+	proposed := new(v1.ConfigMap)
+	if err := request.ReadEntity(proposed); err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	result, err := secret.CreateSecret(k8sClient, spec)
+
+	result, err := configmap.GetConfigMapDiff(k8sClient, namespace, name, proposed)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetSecretDetail(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPersistentVolumeList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
+	dataSelect := parseDataSelectPathParameter(request)
+	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1658,16 +2762,15 @@ func (apiHandler *APIHandler) handleGetSecretDetail(request *restful.Request, re
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetSecretList(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPersistentVolumeDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	dataSelect := parseDataSelectPathParameter(request)
-	namespace := parseNamespacePathParameter(request)
-	result, err := secret.GetSecretList(k8sClient, namespace, dataSelect)
+	name := request.PathParameter("persistentvolume")
+	result, err := persistentvolume.GetPersistentVolumeDetail(k8sClient, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1675,7 +2778,7 @@ func (apiHandler *APIHandler) handleGetSecretList(request *restful.Request, resp
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPersistentVolumeClaimList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1684,7 +2787,7 @@ func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, r
 
 	namespace := parseNamespacePathParameter(request)
 	dataSelect := parseDataSelectPathParameter(request)
-	result, err := configmap.GetConfigMapList(k8sClient, namespace, dataSelect)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1692,7 +2795,7 @@ func (apiHandler *APIHandler) handleGetConfigMapList(request *restful.Request, r
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetConfigMapDetail(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1700,8 +2803,8 @@ func (apiHandler *APIHandler) handleGetConfigMapDetail(request *restful.Request,
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("configmap")
-	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
+	name := request.PathParameter("name")
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(k8sClient, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1709,15 +2812,19 @@ func (apiHandler *APIHandler) handleGetConfigMapDetail(request *restful.Request,
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetPersistentVolumeList(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPersistentVolumeClaimEvents(request *restful.Request,
+	response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
 	dataSelect := parseDataSelectPathParameter(request)
-	result, err := persistentvolume.GetPersistentVolumeList(k8sClient, dataSelect)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1725,15 +2832,16 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeList(request *restful.Req
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetPersistentVolumeDetail(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetPodContainers(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	name := request.PathParameter("persistentvolume")
-	result, err := persistentvolume.GetPersistentVolumeDetail(k8sClient, name)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	result, err := container.GetPodContainers(k8sClient, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1741,24 +2849,23 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeDetail(request *restful.R
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetPersistentVolumeClaimList(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleEvictPod(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
 
-	namespace := parseNamespacePathParameter(request)
-	dataSelect := parseDataSelectPathParameter(request)
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(k8sClient, namespace, dataSelect)
-	if err != nil {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("pod")
+	if err := pod.EvictPod(k8sClient, namespace, name); err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
+	response.WriteHeader(http.StatusOK)
 }
 
-func (apiHandler *APIHandler) handleGetPersistentVolumeClaimDetail(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetContainerEnv(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1766,8 +2873,9 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeClaimDetail(request *rest
 	}
 
 	namespace := request.PathParameter("namespace")
-	name := request.PathParameter("name")
-	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(k8sClient, namespace, name)
+	name := request.PathParameter("pod")
+	containerName := request.PathParameter("container")
+	result, err := container.GetContainerEnv(k8sClient, namespace, name, containerName)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1775,7 +2883,7 @@ func (apiHandler *APIHandler) handleGetPersistentVolumeClaimDetail(request *rest
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-func (apiHandler *APIHandler) handleGetPodContainers(request *restful.Request, response *restful.Response) {
+func (apiHandler *APIHandler) handleGetContainerLastState(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1784,7 +2892,8 @@ func (apiHandler *APIHandler) handleGetPodContainers(request *restful.Request, r
 
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("pod")
-	result, err := container.GetPodContainers(k8sClient, namespace, name)
+	containerName := request.PathParameter("container")
+	result, err := container.GetContainerLastState(k8sClient, namespace, name, containerName)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
 		return
@@ -1802,6 +2911,7 @@ func (apiHandler *APIHandler) handleGetReplicationControllerEvents(request *rest
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("replicationController")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -1865,6 +2975,30 @@ func (apiHandler *APIHandler) handleGetDaemonSetDetail(
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleDeleteDaemonSet(
+	request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("daemonSet")
+
+	// Both flags are optional and default to false when absent or unparseable, so a plain
+	// delete does not fail on a missing parameter.
+	deleteServices := parseBoolQueryParameter(request, "deleteServices")
+	orphanPods := parseBoolQueryParameter(request, "orphanPods")
+
+	result, err := daemonset.DeleteDaemonSet(k8sClient, namespace, name, deleteServices, orphanPods)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetDaemonSetPods(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -1912,6 +3046,7 @@ func (apiHandler *APIHandler) handleGetDaemonSetEvents(request *restful.Request,
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("daemonSet")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -2020,6 +3155,7 @@ func (apiHandler *APIHandler) handleGetJobEvents(request *restful.Request, respo
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := job.GetJobEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -2028,6 +3164,82 @@ func (apiHandler *APIHandler) handleGetJobEvents(request *restful.Request, respo
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleWatchJobStatus(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	watcher, err := job.WatchJobStatus(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.AddHeader("Content-Type", "text/event-stream")
+	response.AddHeader("Cache-Control", "no-cache")
+	response.WriteHeader(http.StatusOK)
+	flusher, _ := response.ResponseWriter.(http.Flusher)
+
+	err = job.StreamJobStatusUpdates(watcher, func(update job.JobStatusUpdate) error {
+		data, err := json.Marshal(update)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(response.ResponseWriter, "data: %s\n\n", data); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error streaming status of %s job in %s namespace: %s", name, namespace, err)
+	}
+}
+
+func (apiHandler *APIHandler) handleWatchEvents(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.QueryParameter("namespace")
+	eventType := request.QueryParameter("type")
+	watcher, err := event.WatchEvents(k8sClient, namespace)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	response.AddHeader("Content-Type", "text/event-stream")
+	response.AddHeader("Cache-Control", "no-cache")
+	response.WriteHeader(http.StatusOK)
+	flusher, _ := response.ResponseWriter.(http.Flusher)
+
+	err = event.StreamEvents(watcher, eventType, func(streamedEvent common.Event) error {
+		data, err := json.Marshal(streamedEvent)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(response.ResponseWriter, "data: %s\n\n", data); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error streaming events: %s", err)
+	}
+}
+
 func (apiHandler *APIHandler) handleGetCronJobList(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -2094,6 +3306,7 @@ func (apiHandler *APIHandler) handleGetCronJobEvents(request *restful.Request, r
 	namespace := request.PathParameter("namespace")
 	name := request.PathParameter("name")
 	dataSelect := parseDataSelectPathParameter(request)
+	dataSelect = prepareEventDownload(request, response, dataSelect)
 	result, err := cronjob.GetCronJobEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		kdErrors.HandleInternalError(response, err)
@@ -2118,6 +3331,89 @@ func (apiHandler *APIHandler) handleGetStorageClassList(request *restful.Request
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
+func (apiHandler *APIHandler) handleGetAdmissionWebhookList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	result, err := webhook.GetAdmissionWebhookList(k8sClient)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNetworkPolicyList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parseDataSelectPathParameter(request)
+	result, err := networkpolicy.GetNetworkPolicyList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetNetworkPolicyDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := networkpolicy.GetNetworkPolicyDetail(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetPodDisruptionBudgetList(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := parseNamespacePathParameter(request)
+	dataSelect := parseDataSelectPathParameter(request)
+	result, err := poddisruptionbudget.GetPodDisruptionBudgetList(k8sClient, namespace, dataSelect)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleGetPodDisruptionBudgetDetail(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("name")
+	result, err := poddisruptionbudget.GetPodDisruptionBudgetDetail(k8sClient, namespace, name)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
 func (apiHandler *APIHandler) handleGetStorageClass(request *restful.Request, response *restful.Response) {
 	k8sClient, err := apiHandler.cManager.Client(request)
 	if err != nil {
@@ -2202,6 +3498,45 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 	podID := request.PathParameter("pod")
 	containerID := request.PathParameter("container")
 
+	usePreviousLogs := request.QueryParameter("previous") == "true"
+	filter := request.QueryParameter("grep")
+	classify := request.QueryParameter("classify") == "true"
+	logSelector := parseLogSelection(request)
+
+	result, err := container.GetLogDetails(k8sClient, namespace, podID, containerID, logSelector,
+		usePreviousLogs, filter, classify)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *APIHandler) handleAllContainersLogs(request *restful.Request, response *restful.Response) {
+	k8sClient, err := apiHandler.cManager.Client(request)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+	podID := request.PathParameter("pod")
+
+	usePreviousLogs := request.QueryParameter("previous") == "true"
+	logSelector := parseLogSelection(request)
+
+	result, err := container.GetAllContainersLogDetails(k8sClient, namespace, podID, logSelector,
+		usePreviousLogs)
+	if err != nil {
+		kdErrors.HandleInternalError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// parseLogSelection parses the log view selection from request query parameters, falling back
+// to the default selection on invalid input.
+func parseLogSelection(request *restful.Request) *logs.Selection {
 	refTimestamp := request.QueryParameter("referenceTimestamp")
 	if refTimestamp == "" {
 		refTimestamp = logs.NewestTimestamp
@@ -2211,7 +3546,6 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 	if err != nil {
 		refLineNum = 0
 	}
-	usePreviousLogs := request.QueryParameter("previous") == "true"
 	offsetFrom, err1 := strconv.Atoi(request.QueryParameter("offsetFrom"))
 	offsetTo, err2 := strconv.Atoi(request.QueryParameter("offsetTo"))
 	logFilePosition := request.QueryParameter("logFilePosition")
@@ -2228,13 +3562,7 @@ func (apiHandler *APIHandler) handleLogs(request *restful.Request, response *res
 			LogFilePosition: logFilePosition,
 		}
 	}
-
-	result, err := container.GetLogDetails(k8sClient, namespace, podID, containerID, logSelector, usePreviousLogs)
-	if err != nil {
-		kdErrors.HandleInternalError(response, err)
-		return
-	}
-	response.WriteHeaderAndEntity(http.StatusOK, result)
+	return logSelector
 }
 
 func (apiHandler *APIHandler) handleLogFile(request *restful.Request, response *restful.Response) {
@@ -2256,6 +3584,13 @@ func (apiHandler *APIHandler) handleLogFile(request *restful.Request, response *
 	handleDownload(response, logStream)
 }
 
+// parseBoolQueryParameter returns the boolean query parameter with the given name, defaulting
+// to false when the parameter is absent or not a valid boolean.
+func parseBoolQueryParameter(request *restful.Request, name string) bool {
+	value, err := strconv.ParseBool(request.QueryParameter(name))
+	return err == nil && value
+}
+
 // parseNamespacePathParameter parses namespace selector for list pages in path parameter.
 // The namespace selector is a comma separated list of namespaces that are trimmed.
 // No namespaces means "view all user namespaces", i.e., everything except kube-system.
@@ -16,6 +16,7 @@ package handler
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"bytes"
@@ -23,6 +24,7 @@ import (
 	"strings"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	"github.com/kubernetes/dashboard/src/app/backend/auth"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	"github.com/kubernetes/dashboard/src/app/backend/auth/jwe"
@@ -102,6 +104,30 @@ func TestMapUrlToResource(t *testing.T) {
 	}
 }
 
+func TestParseBoolQueryParameter(t *testing.T) {
+	cases := []struct {
+		url      string
+		expected bool
+	}{
+		{"/api/v1/daemonset/ns-1/ds-1", false},
+		{"/api/v1/daemonset/ns-1/ds-1?deleteServices=true", true},
+		{"/api/v1/daemonset/ns-1/ds-1?deleteServices=1", true},
+		{"/api/v1/daemonset/ns-1/ds-1?deleteServices=false", false},
+		{"/api/v1/daemonset/ns-1/ds-1?deleteServices=not-a-bool", false},
+	}
+	for _, c := range cases {
+		httpRequest, err := http.NewRequest("DELETE", c.url, nil)
+		if err != nil {
+			t.Fatalf("Cannot mockup request for %s: %v", c.url, err)
+		}
+		request := &restful.Request{Request: httpRequest}
+		if actual := parseBoolQueryParameter(request, "deleteServices"); actual != c.expected {
+			t.Errorf("parseBoolQueryParameter(%#v, deleteServices) returns %t, expected %t",
+				c.url, actual, c.expected)
+		}
+	}
+}
+
 func TestFormatRequestLog(t *testing.T) {
 	req, err := http.NewRequest("PUT", "/api/v1/pod", bytes.NewReader([]byte("{}")))
 	if err != nil {
@@ -125,3 +151,63 @@ func TestFormatRequestLog(t *testing.T) {
 		}
 	}
 }
+
+func TestApiDocs(t *testing.T) {
+	cManager := client.NewClientManager("", "http://localhost:8080")
+	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{})
+	sManager := settings.NewSettingsManager(cManager)
+	sbManager := systembanner.NewSystemBannerManager("Hello world!", "INFO")
+	apiHandler, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager)
+	if err != nil {
+		t.Fatal("CreateHTTPAPIHandler() cannot create HTTP API handler")
+	}
+
+	httpRequest, _ := http.NewRequest("GET", "/apidocs.json/api/v1", nil)
+	httpRequest.Header.Set("Accept", restful.MIME_JSON)
+	httpWriter := httptest.NewRecorder()
+	apiHandler.ServeHTTP(httpWriter, httpRequest)
+
+	if httpWriter.Code != http.StatusOK {
+		t.Fatalf("ServeHTTP(GET /apidocs.json/api/v1): got status %d, expected %d",
+			httpWriter.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(httpWriter.Body.String(), "/pod/{namespace}") {
+		t.Errorf("ServeHTTP(GET /apidocs.json/api/v1): spec does not list the /pod/{namespace} route")
+	}
+}
+
+func TestCorsFilter(t *testing.T) {
+	args.GetHolderBuilder().SetCorsAllowedOrigins([]string{"https://allowed.example.com"})
+	defer args.GetHolderBuilder().SetCorsAllowedOrigins(nil)
+
+	cManager := client.NewClientManager("", "http://localhost:8080")
+	authManager := auth.NewAuthManager(cManager, getTokenManager(), authApi.AuthenticationModes{})
+	sManager := settings.NewSettingsManager(cManager)
+	sbManager := systembanner.NewSystemBannerManager("Hello world!", "INFO")
+	apiHandler, err := CreateHTTPAPIHandler(nil, cManager, authManager, sManager, sbManager)
+	if err != nil {
+		t.Fatal("CreateHTTPAPIHandler() cannot create HTTP API handler")
+	}
+
+	cases := []struct {
+		origin, expected string
+	}{
+		{"https://allowed.example.com", "https://allowed.example.com"},
+		{"https://disallowed.example.com", ""},
+	}
+
+	for _, c := range cases {
+		httpRequest, _ := http.NewRequest("OPTIONS", "/api/v1/pod", nil)
+		httpRequest.Header.Set("Origin", c.origin)
+		httpRequest.Header.Set("Access-Control-Request-Method", "GET")
+		httpWriter := httptest.NewRecorder()
+		apiHandler.ServeHTTP(httpWriter, httpRequest)
+
+		actual := httpWriter.Header().Get("Access-Control-Allow-Origin")
+		if actual != c.expected {
+			t.Errorf("Preflight from %s got Access-Control-Allow-Origin %q, expected %q",
+				c.origin, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"k8s.io/client-go/kubernetes"
+)
+
+// noMetricsBackend is reported as the metrics backend when no metric integration is active.
+const noMetricsBackend = "none"
+
+// ClusterInfo contains basic information about the cluster dashboard is connected to. It is
+// used for support diagnostics from the UI and deliberately contains no credentials.
+type ClusterInfo struct {
+	// ServerVersion is the version of the apiserver.
+	ServerVersion string `json:"serverVersion"`
+
+	// ApiServerHost is the address of the apiserver dashboard connects to.
+	ApiServerHost string `json:"apiServerHost"`
+
+	// MetricsBackend is the id of the active metric integration (e.g. heapster) or "none"
+	// when no metric integration is enabled.
+	MetricsBackend string `json:"metricsBackend"`
+}
+
+// getClusterInfo returns cluster information built from the given clients. Only the apiserver
+// host is exposed - bearer tokens and other credentials from the client configuration are not.
+func getClusterInfo(client kubernetes.Interface, metricClient metricapi.MetricClient,
+	apiServerHost string) (*ClusterInfo, error) {
+	versionInfo, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	metricsBackend := noMetricsBackend
+	if metricClient != nil {
+		metricsBackend = string(metricClient.ID())
+	}
+
+	return &ClusterInfo{
+		ServerVersion:  versionInfo.GitVersion,
+		ApiServerHost:  apiServerHost,
+		MetricsBackend: metricsBackend,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeMetricClient is a no-op metric client identifying itself as heapster.
+type fakeMetricClient struct{}
+
+func (fakeMetricClient) DownloadMetric(selectors []metricapi.ResourceSelector, metricName string,
+	cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	return metricapi.MetricPromises{}
+}
+
+func (fakeMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	return metricapi.MetricPromises{}
+}
+
+func (fakeMetricClient) AggregateMetrics(metrics metricapi.MetricPromises, metricName string,
+	aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return metrics
+}
+
+func (fakeMetricClient) HealthCheck() error { return nil }
+
+func (fakeMetricClient) ID() integrationapi.IntegrationID {
+	return integrationapi.HeapsterIntegrationID
+}
+
+func TestGetClusterInfo(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{
+		GitVersion: "v1.9.3",
+	}
+
+	cases := []struct {
+		metricClient  metricapi.MetricClient
+		apiServerHost string
+		expected      *ClusterInfo
+	}{
+		{
+			fakeMetricClient{},
+			"https://192.168.99.100:8443",
+			&ClusterInfo{
+				ServerVersion:  "v1.9.3",
+				ApiServerHost:  "https://192.168.99.100:8443",
+				MetricsBackend: "heapster",
+			},
+		},
+		{
+			nil,
+			"https://192.168.99.100:8443",
+			&ClusterInfo{
+				ServerVersion:  "v1.9.3",
+				ApiServerHost:  "https://192.168.99.100:8443",
+				MetricsBackend: noMetricsBackend,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := getClusterInfo(fakeClient, c.metricClient, c.apiServerHost)
+		if err != nil {
+			t.Fatalf("getClusterInfo(client, metricClient, host) returned error: %s", err)
+		}
+
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getClusterInfo(client, metricClient, host) == \ngot %#v, \nexpected %#v",
+				actual, c.expected)
+		}
+	}
+}
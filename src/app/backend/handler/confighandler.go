@@ -20,6 +20,8 @@ import (
 	"net/http"
 	"text/template"
 	"time"
+
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 )
 
 // AppHandler is an application handler.
@@ -29,6 +31,23 @@ type AppHandler func(http.ResponseWriter, *http.Request) (int, error)
 type AppConfig struct {
 	// ServerTime is current server time (milliseconds elapsed since 1 January 1970 00:00:00 UTC).
 	ServerTime int64 `json:"serverTime"`
+
+	// FeatureFlags describes the optional features available in this deployment.
+	FeatureFlags FeatureFlags `json:"featureFlags"`
+}
+
+// FeatureFlags lists the optional dashboard features available in this deployment, so the
+// frontend can hide what is not supported.
+type FeatureFlags struct {
+	// MetricsBackend identifies the active metric provider, "none" when metrics are not
+	// available.
+	MetricsBackend string `json:"metricsBackend"`
+
+	// ExecEnabled is true when the exec-into-container terminal is served.
+	ExecEnabled bool `json:"execEnabled"`
+
+	// LogFollowEnabled is true when log streaming is served.
+	LogFollowEnabled bool `json:"logFollowEnabled"`
 }
 
 const (
@@ -46,12 +65,13 @@ func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getAppConfigJSON() string {
+func getAppConfigJSON(featureFlags FeatureFlags) string {
 	log.Println("Getting application global configuration")
 
 	config := &AppConfig{
 		// TODO(maciaszczykm): Get time from API server instead directly from backend.
-		ServerTime: time.Now().UTC().UnixNano() / 1e6,
+		ServerTime:   time.Now().UTC().UnixNano() / 1e6,
+		FeatureFlags: featureFlags,
 	}
 
 	jsonConfig, _ := json.Marshal(config)
@@ -59,11 +79,25 @@ func getAppConfigJSON() string {
 	return string(jsonConfig)
 }
 
-func ConfigHandler(w http.ResponseWriter, r *http.Request) (int, error) {
-	configTemplate, err := template.New(ConfigTemplateName).Parse(ConfigTemplate)
-	w.Header().Set("Content-Type", "application/javascript")
-	if err != nil {
-		return http.StatusInternalServerError, err
+// CreateConfigHandler returns an AppHandler serving the application configuration together
+// with feature flags derived from the given metric client and endpoint availability.
+func CreateConfigHandler(metricClient metricapi.MetricClient, execEnabled,
+	logFollowEnabled bool) AppHandler {
+	featureFlags := FeatureFlags{
+		MetricsBackend:   noMetricsBackend,
+		ExecEnabled:      execEnabled,
+		LogFollowEnabled: logFollowEnabled,
+	}
+	if metricClient != nil {
+		featureFlags.MetricsBackend = string(metricClient.ID())
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) (int, error) {
+		configTemplate, err := template.New(ConfigTemplateName).Parse(ConfigTemplate)
+		w.Header().Set("Content-Type", "application/javascript")
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		return http.StatusOK, configTemplate.Execute(w, getAppConfigJSON(featureFlags))
 	}
-	return http.StatusOK, configTemplate.Execute(w, getAppConfigJSON())
 }
@@ -0,0 +1,66 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCreateConfigHandler(t *testing.T) {
+	cases := []struct {
+		info     string
+		handler  AppHandler
+		expected FeatureFlags
+	}{
+		{
+			"flags reflect the active metric client and enabled endpoints",
+			CreateConfigHandler(fakeMetricClient{}, true, true),
+			FeatureFlags{MetricsBackend: "heapster", ExecEnabled: true, LogFollowEnabled: true},
+		},
+		{
+			"no metric client reports the none backend",
+			CreateConfigHandler(nil, true, false),
+			FeatureFlags{MetricsBackend: "none", ExecEnabled: true, LogFollowEnabled: false},
+		},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		c.handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/appConfig.json", nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("GET /api/appConfig.json returned status %d, expected %d (%s)",
+				recorder.Code, http.StatusOK, c.info)
+		}
+
+		// The body is a javascript assignment wrapping the configuration JSON.
+		body := recorder.Body.String()
+		jsonConfig := strings.TrimPrefix(body, "var appConfig_DO_NOT_USE_DIRECTLY = ")
+		config := AppConfig{}
+		if err := json.Unmarshal([]byte(jsonConfig), &config); err != nil {
+			t.Fatalf("Could not parse configuration %q: %s", body, err)
+		}
+
+		if !reflect.DeepEqual(config.FeatureFlags, c.expected) {
+			t.Errorf("GET /api/appConfig.json returned feature flags %#v, expected %#v (%s)",
+				config.FeatureFlags, c.expected, c.info)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	restful "github.com/emicklei/go-restful"
 	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 )
 
 func handleDownload(response *restful.Response, result io.ReadCloser) {
@@ -30,3 +31,17 @@ func handleDownload(response *restful.Response, result io.ReadCloser) {
 		return
 	}
 }
+
+// prepareEventDownload turns an event request into a download when the 'download=true' query
+// parameter is set: pagination is bypassed so the full list is returned and the response is
+// marked as an attachment. Plain requests keep their data select query untouched.
+func prepareEventDownload(request *restful.Request, response *restful.Response,
+	dataSelect *dataselect.DataSelectQuery) *dataselect.DataSelectQuery {
+	if request.QueryParameter("download") != "true" {
+		return dataSelect
+	}
+
+	response.AddHeader("Content-Disposition", `attachment; filename="events.json"`)
+	return dataselect.NewDataSelectQuery(dataselect.NoPagination, dataSelect.SortQuery,
+		dataSelect.FilterQuery, dataSelect.MetricQuery)
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	restful "github.com/emicklei/go-restful"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+)
+
+func TestPrepareEventDownload(t *testing.T) {
+	httpRequest := httptest.NewRequest("GET", "/api/v1/pod/ns-1/pod-1/event?download=true", nil)
+	request := restful.NewRequest(httpRequest)
+	recorder := httptest.NewRecorder()
+	response := restful.NewResponse(recorder)
+
+	dataSelect := prepareEventDownload(request, response, dataselect.DefaultDataSelect)
+
+	expectedDisposition := `attachment; filename="events.json"`
+	if disposition := recorder.Header().Get("Content-Disposition"); disposition != expectedDisposition {
+		t.Errorf("prepareEventDownload(download=true) set disposition %q, expected %q",
+			disposition, expectedDisposition)
+	}
+	if dataSelect.PaginationQuery != dataselect.NoPagination {
+		t.Errorf("prepareEventDownload(download=true) kept pagination %#v, expected no pagination",
+			dataSelect.PaginationQuery)
+	}
+
+	httpRequest = httptest.NewRequest("GET", "/api/v1/pod/ns-1/pod-1/event", nil)
+	request = restful.NewRequest(httpRequest)
+	recorder = httptest.NewRecorder()
+	response = restful.NewResponse(recorder)
+
+	dataSelect = prepareEventDownload(request, response, dataselect.DefaultDataSelect)
+
+	if disposition := recorder.Header().Get("Content-Disposition"); disposition != "" {
+		t.Errorf("prepareEventDownload() set disposition %q on a plain request", disposition)
+	}
+	if dataSelect != dataselect.DefaultDataSelect {
+		t.Errorf("prepareEventDownload() changed the data select query of a plain request")
+	}
+}
@@ -15,15 +15,24 @@
 package handler
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log"
+	"net"
 	"net/http"
+	"runtime/debug"
 	"strings"
 	"time"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
 	authApi "github.com/kubernetes/dashboard/src/app/backend/auth/api"
 	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
 	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -32,12 +41,371 @@ import (
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 )
 
-// InstallFilters installs defined filter for given web service
+// InstallFilters installs defined filter for given web service. The body size limit must come
+// before the request logger: the logger reads the whole body through ReadEntity, so anything
+// installed ahead of the limit gets the uncapped stream.
 func InstallFilters(ws *restful.WebService, manager clientapi.ClientManager) {
+	ws.Filter(requestIDFilter)
+	ws.Filter(recoverPanicFilter)
+	ws.Filter(maxBodySizeFilter)
 	ws.Filter(requestAndResponseLogger)
 	ws.Filter(metricsFilter)
 	ws.Filter(validateXSRFFilter(manager.CSRFKey()))
 	ws.Filter(restrictedResourcesFilter)
+	ws.Filter(etagFilter)
+}
+
+const (
+	// requestIDHeader is the header carrying the request identifier.
+	requestIDHeader = "X-Request-ID"
+
+	// requestIDAttribute is the request attribute the identifier is stored under for
+	// downstream handlers.
+	requestIDAttribute = "requestID"
+)
+
+// Filter that assigns every request an identifier, honoring one supplied by the client, and
+// propagates it in the response header, the access log and a request attribute, so a failing
+// request can be traced across the UI and the backend log.
+func requestIDFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	requestID := request.Request.Header.Get(requestIDHeader)
+	if requestID == "" {
+		requestID = generateRequestID()
+	}
+	request.SetAttribute(requestIDAttribute, requestID)
+	response.AddHeader(requestIDHeader, requestID)
+	chain.ProcessFilter(request, response)
+}
+
+// requestID returns the identifier assigned to the request, empty when the request id filter
+// did not run.
+func requestID(request *restful.Request) string {
+	if id, ok := request.Attribute(requestIDAttribute).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// recoveredPanicResponse is the JSON body returned when a handler panics. The request id
+// correlates the response with the stack trace in the server log.
+type recoveredPanicResponse struct {
+	// Error message presented to the client.
+	Error string `json:"error"`
+
+	// RequestID identifies the failed request in the server log.
+	RequestID string `json:"requestId"`
+}
+
+// Filter that recovers panics of downstream handlers, logs a stack trace and returns a 500
+// JSON error, so a programming error in a single handler cannot bring down the server.
+func recoverPanicFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			requestID := requestID(request)
+			if requestID == "" {
+				requestID = generateRequestID()
+			}
+			log.Printf("[%s] Recovered from panic while handling %s %s: %v\n%s", requestID,
+				request.Request.Method, request.Request.URL.Path, recovered, debug.Stack())
+			response.WriteHeaderAndJson(http.StatusInternalServerError,
+				recoveredPanicResponse{Error: "Internal server error", RequestID: requestID},
+				restful.MIME_JSON)
+		}
+	}()
+	chain.ProcessFilter(request, response)
+}
+
+// generateRequestID returns a random identifier that correlates an error response with the
+// server log.
+func generateRequestID() string {
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(idBytes)
+}
+
+// Filter that rejects mutating requests whose body exceeds the limit configured with the
+// max-request-body argument with 413 Request Entity Too Large, so a single oversized upload
+// cannot exhaust memory. Bodies of unknown length are capped while being read.
+func maxBodySizeFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	limit := int64(args.Holder.GetMaxRequestBody())
+	if limit <= 0 || !isMutatingRequest(request.Request.Method) || request.Request.Body == nil {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	if request.Request.ContentLength > limit {
+		err := errors.New("Request body too large")
+		log.Print(err)
+		response.AddHeader("Content-Type", "text/plain")
+		response.WriteErrorString(http.StatusRequestEntityTooLarge, err.Error()+"\n")
+		return
+	}
+
+	request.Request.Body = http.MaxBytesReader(response.ResponseWriter, request.Request.Body, limit)
+	chain.ProcessFilter(request, response)
+}
+
+// isMutatingRequest tells whether the method can modify cluster state and therefore carries a
+// request body worth limiting.
+func isMutatingRequest(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// streamingPathPrefixes lists URL path prefixes of routes that deliver their response
+// incrementally, e.g. raw log file downloads and the service proxy. Filters must not buffer
+// their bodies: a single log download can be gigabytes.
+var streamingPathPrefixes = []string{
+	"/api/v1/event/watch",
+	"/api/v1/log/file/",
+	"/api/v1/proxy/",
+}
+
+// streamingPathSuffixes complements streamingPathPrefixes for streaming routes whose fixed
+// segment sits at the end of the path, e.g. the SSE job status watch.
+var streamingPathSuffixes = []string{
+	"/status/watch",
+}
+
+// isStreamingRequest tells whether the response is delivered incrementally and therefore
+// cannot be buffered or transformed as a whole, either because of a protocol upgrade or
+// because the URL belongs to a streaming route.
+func isStreamingRequest(request *restful.Request) bool {
+	if len(request.Request.Header.Get("Upgrade")) > 0 {
+		return true
+	}
+	path := request.Request.URL.Path
+	for _, prefix := range streamingPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	for _, suffix := range streamingPathSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponseWriter buffers the response so that filters can inspect or transform the
+// full body before anything is sent to the client. Buffering is abandoned and the response
+// streams straight through when the handler needs incremental delivery (Flush or Hijack) or
+// the body outgrows maxBuffered.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+
+	// maxBuffered caps the body size held in memory, 0 means no cap.
+	maxBuffered int
+	passthrough bool
+}
+
+func (writer *bufferedResponseWriter) WriteHeader(status int) {
+	if writer.passthrough {
+		writer.ResponseWriter.WriteHeader(status)
+		return
+	}
+	writer.status = status
+}
+
+func (writer *bufferedResponseWriter) Write(data []byte) (int, error) {
+	if !writer.passthrough && writer.maxBuffered > 0 &&
+		writer.body.Len()+len(data) > writer.maxBuffered {
+		writer.stopBuffering()
+	}
+	if writer.passthrough {
+		return writer.ResponseWriter.Write(data)
+	}
+	return writer.body.Write(data)
+}
+
+// stopBuffering sends the response head and everything buffered so far to the underlying
+// writer and passes all further writes straight through, giving up on whatever
+// transformation the buffering was for.
+func (writer *bufferedResponseWriter) stopBuffering() {
+	if writer.passthrough {
+		return
+	}
+	writer.passthrough = true
+	writer.ResponseWriter.WriteHeader(writer.status)
+	if writer.body.Len() > 0 {
+		writer.ResponseWriter.Write(writer.body.Bytes())
+		writer.body.Reset()
+	}
+}
+
+// Flush implements http.Flusher. A handler flushing mid-response needs incremental delivery,
+// so buffering stops and the flush is forwarded to the real connection.
+func (writer *bufferedResponseWriter) Flush() {
+	writer.stopBuffering()
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, handing the underlying connection to handlers that need
+// it directly.
+func (writer *bufferedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := writer.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("Underlying response writer does not support hijacking")
+	}
+	writer.passthrough = true
+	return hijacker.Hijack()
+}
+
+// gzipMinSize is the smallest response body worth compressing. Smaller payloads fit into a
+// single packet anyway, so compressing them only wastes CPU.
+const gzipMinSize = 1024
+
+// gzipFilterWriter compresses the response as it is written. Only the first gzipMinSize
+// bytes are sniffed before anything is sent, so that tiny responses go out uncompressed; once
+// the threshold is crossed the body streams through a gzip writer without further buffering.
+type gzipFilterWriter struct {
+	http.ResponseWriter
+	level  int
+	status int
+
+	// sniff holds the body prefix until the compression decision is made.
+	sniff      []byte
+	decided    bool
+	gzipWriter *gzip.Writer
+}
+
+func (writer *gzipFilterWriter) WriteHeader(status int) {
+	if !writer.decided {
+		writer.status = status
+	}
+}
+
+func (writer *gzipFilterWriter) Write(data []byte) (int, error) {
+	if !writer.decided {
+		writer.sniff = append(writer.sniff, data...)
+		if len(writer.sniff) >= gzipMinSize {
+			writer.decide(true)
+		}
+		return len(data), nil
+	}
+	if writer.gzipWriter != nil {
+		return writer.gzipWriter.Write(data)
+	}
+	return writer.ResponseWriter.Write(data)
+}
+
+// decide sends the response head and the sniffed body prefix, compressed or not, and routes
+// all further writes the same way.
+func (writer *gzipFilterWriter) decide(compress bool) {
+	writer.decided = true
+	if compress {
+		writer.Header().Del("Content-Length")
+		writer.Header().Set("Content-Encoding", "gzip")
+	}
+	writer.ResponseWriter.WriteHeader(writer.status)
+	if compress {
+		gzipWriter, err := gzip.NewWriterLevel(writer.ResponseWriter, writer.level)
+		if err != nil {
+			gzipWriter = gzip.NewWriter(writer.ResponseWriter)
+		}
+		writer.gzipWriter = gzipWriter
+		gzipWriter.Write(writer.sniff)
+	} else if len(writer.sniff) > 0 {
+		writer.ResponseWriter.Write(writer.sniff)
+	}
+	writer.sniff = nil
+}
+
+// Flush implements http.Flusher. A flush forces the compression decision on what has been
+// written so far and is forwarded to the real connection, so streaming handlers deliver
+// incrementally.
+func (writer *gzipFilterWriter) Flush() {
+	if !writer.decided {
+		writer.decide(len(writer.sniff) >= gzipMinSize)
+	}
+	if writer.gzipWriter != nil {
+		writer.gzipWriter.Flush()
+	}
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// finish sends responses that stayed below the sniff threshold and terminates the gzip
+// stream.
+func (writer *gzipFilterWriter) finish() {
+	if !writer.decided {
+		writer.decide(len(writer.sniff) >= gzipMinSize)
+	}
+	if writer.gzipWriter != nil {
+		writer.gzipWriter.Close()
+	}
+}
+
+// Filter that gzips response bodies for clients that accept it, at the level configured with
+// the gzip-level argument. The body is compressed while it streams out, so large downloads are
+// never held in memory. Compression is skipped entirely when the level is 0, for bodies
+// smaller than gzipMinSize and for streaming routes.
+func gzipFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	level := args.Holder.GetGzipLevel()
+	acceptEncoding := request.Request.Header.Get("Accept-Encoding")
+	if level == 0 || !strings.Contains(acceptEncoding, "gzip") || isStreamingRequest(request) {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	writer := &gzipFilterWriter{ResponseWriter: response.ResponseWriter, level: level,
+		status: http.StatusOK}
+	original := response.ResponseWriter
+	response.ResponseWriter = writer
+	chain.ProcessFilter(request, response)
+	response.ResponseWriter = original
+	writer.finish()
+}
+
+// etagMaxBodySize caps how much of a response the ETag filter may hold in memory. Larger
+// responses are sent untagged instead of being buffered.
+const etagMaxBodySize = 10 << 20
+
+// Filter that adds a weak ETag to successful GET responses of the JSON API and replies with
+// 304 Not Modified when the client already has the current version of the resource. The tag is
+// derived from the response body, which only changes when the resource versions of the listed
+// objects change, so polling clients skip re-downloading unchanged lists. Streaming routes
+// need incremental delivery and are left alone; a handler flushing mid-response opts out of
+// the tag as well.
+func etagFilter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if request.Request.Method != http.MethodGet || isStreamingRequest(request) {
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	writer := &bufferedResponseWriter{ResponseWriter: response.ResponseWriter,
+		status: http.StatusOK, maxBuffered: etagMaxBodySize}
+	original := response.ResponseWriter
+	response.ResponseWriter = writer
+	chain.ProcessFilter(request, response)
+	response.ResponseWriter = original
+
+	// The body already streamed to the client, nothing left to tag or send.
+	if writer.passthrough {
+		return
+	}
+
+	if writer.status == http.StatusOK && writer.body.Len() > 0 {
+		hash := fnv.New64a()
+		hash.Write(writer.body.Bytes())
+		etag := fmt.Sprintf("W/\"%x\"", hash.Sum64())
+
+		response.Header().Set("ETag", etag)
+		if request.Request.Header.Get("If-None-Match") == etag {
+			response.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	response.WriteHeader(writer.status)
+	response.Write(writer.body.Bytes())
 }
 
 // Filter used to restrict access to dashboard exclusive resource, i.e. secret used to store dashboard encryption key.
@@ -76,13 +444,13 @@ func formatRequestLog(request *restful.Request) string {
 		}
 	}
 
-	return fmt.Sprintf(RequestLogString, time.Now().Format(time.RFC3339), request.Request.Proto,
-		request.Request.Method, uri, request.Request.RemoteAddr, content)
+	return fmt.Sprintf(RequestLogString, time.Now().Format(time.RFC3339), requestID(request),
+		request.Request.Proto, request.Request.Method, uri, request.Request.RemoteAddr, content)
 }
 
 // formatResponseLog formats response log string.
 func formatResponseLog(response *restful.Response, request *restful.Request) string {
-	return fmt.Sprintf(ResponseLogString, time.Now().Format(time.RFC3339),
+	return fmt.Sprintf(ResponseLogString, time.Now().Format(time.RFC3339), requestID(request),
 		request.Request.RemoteAddr, response.StatusCode())
 }
 
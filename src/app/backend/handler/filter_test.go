@@ -0,0 +1,483 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/src/app/backend/args"
+)
+
+func TestETagFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(etagFilter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeaderAndEntity(http.StatusOK, map[string]string{"key": "value"})
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code: %d, expected %d", recorder.Code, http.StatusOK)
+	}
+
+	etag := recorder.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("Expected ETag header on GET response, got none")
+	}
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("If-None-Match", etag)
+	recorder = httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNotModified {
+		t.Errorf("Unexpected status code for matching If-None-Match: %d, expected %d",
+			recorder.Code, http.StatusNotModified)
+	}
+	if recorder.Body.Len() != 0 {
+		t.Errorf("Unexpected body for 304 response: %s", recorder.Body.String())
+	}
+}
+
+func TestETagFilterSkipsStreamingRoutes(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(etagFilter)
+	ws.Route(ws.GET("/log/file/{namespace}/{pod}/{container}").
+		To(func(request *restful.Request, response *restful.Response) {
+			response.Write([]byte("log line\n"))
+		}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/log/file/ns-1/pod-1/container-1", nil)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if etag := recorder.Header().Get("ETag"); etag != "" {
+		t.Errorf("Unexpected ETag %q on streaming route, expected none", etag)
+	}
+	if recorder.Body.String() != "log line\n" {
+		t.Errorf("Unexpected body %q on streaming route", recorder.Body.String())
+	}
+}
+
+func TestIsStreamingRequest(t *testing.T) {
+	cases := []struct {
+		path     string
+		upgrade  string
+		expected bool
+	}{
+		{"/api/v1/log/file/ns-1/pod-1/container-1", "", true},
+		{"/api/v1/proxy/svc-1/path", "", true},
+		{"/api/v1/job/ns-1/job-1/status/watch", "", true},
+		{"/api/v1/event/watch", "", true},
+		{"/api/v1/event/watch?namespace=ns-1", "", true},
+		{"/api/v1/pod/ns-1/pod-1/metrics/live", "websocket", true},
+		{"/api/v1/pod", "", false},
+		{"/api/v1/job/ns-1/job-1", "", false},
+	}
+
+	for _, c := range cases {
+		httpRequest := httptest.NewRequest(http.MethodGet, c.path, nil)
+		if c.upgrade != "" {
+			httpRequest.Header.Set("Upgrade", c.upgrade)
+		}
+		actual := isStreamingRequest(&restful.Request{Request: httpRequest})
+		if actual != c.expected {
+			t.Errorf("isStreamingRequest(%s, upgrade=%q) == %t, expected %t", c.path, c.upgrade,
+				actual, c.expected)
+		}
+	}
+}
+
+func TestETagFilterPassesFlushThrough(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(etagFilter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		flusher, ok := response.ResponseWriter.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the response writer to implement http.Flusher")
+		}
+		response.Write([]byte("first"))
+		flusher.Flush()
+		response.Write([]byte("second"))
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if !recorder.Flushed {
+		t.Error("Expected the flush to reach the underlying connection")
+	}
+	if etag := recorder.Header().Get("ETag"); etag != "" {
+		t.Errorf("Unexpected ETag %q on flushed response, expected none", etag)
+	}
+	if recorder.Body.String() != "firstsecond" {
+		t.Errorf("Unexpected body %q, expected %q", recorder.Body.String(), "firstsecond")
+	}
+}
+
+func TestGzipFilter(t *testing.T) {
+	args.GetHolderBuilder().SetGzipLevel(gzip.DefaultCompression)
+	defer args.GetHolderBuilder().SetGzipLevel(0)
+
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("/small").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeaderAndEntity(http.StatusOK, map[string]string{"key": "value"})
+	}))
+	ws.Route(ws.GET("/large").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeaderAndEntity(http.StatusOK,
+			map[string]string{"key": strings.Repeat("value", 1024)})
+	}))
+	container := restful.NewContainer()
+	container.Filter(gzipFilter)
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/small", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code: %d, expected %d", recorder.Code, http.StatusOK)
+	}
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "" {
+		t.Errorf("Unexpected Content-Encoding %q for small response, expected none", encoding)
+	}
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/large", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("Accept-Encoding", "gzip")
+	recorder = httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code: %d, expected %d", recorder.Code, http.StatusOK)
+	}
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "gzip" {
+		t.Fatalf("Unexpected Content-Encoding %q for large response, expected gzip", encoding)
+	}
+
+	reader, err := gzip.NewReader(recorder.Body)
+	if err != nil {
+		t.Fatalf("Cannot read gzipped response: %s", err)
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Cannot read gzipped response: %s", err)
+	}
+	if !strings.Contains(string(body), strings.Repeat("value", 1024)) {
+		t.Error("Decompressed response does not contain the original entity")
+	}
+}
+
+func TestGzipFilterPassesFlushThrough(t *testing.T) {
+	args.GetHolderBuilder().SetGzipLevel(gzip.DefaultCompression)
+	defer args.GetHolderBuilder().SetGzipLevel(0)
+
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("/stream").To(func(request *restful.Request, response *restful.Response) {
+		flusher, ok := response.ResponseWriter.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the response writer to implement http.Flusher")
+		}
+		response.Write([]byte("data: first\n\n"))
+		flusher.Flush()
+		response.Write([]byte("data: second\n\n"))
+		flusher.Flush()
+	}))
+	container := restful.NewContainer()
+	container.Filter(gzipFilter)
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if !recorder.Flushed {
+		t.Error("Expected the flush to reach the underlying connection")
+	}
+	// The first flush came below the sniff threshold, so the stream stays uncompressed.
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "" {
+		t.Errorf("Unexpected Content-Encoding %q for flushed stream, expected none", encoding)
+	}
+	if recorder.Body.String() != "data: first\n\ndata: second\n\n" {
+		t.Errorf("Unexpected body %q for flushed stream", recorder.Body.String())
+	}
+}
+
+func TestEventWatchStreamsThroughFilters(t *testing.T) {
+	args.GetHolderBuilder().SetGzipLevel(gzip.DefaultCompression)
+	defer args.GetHolderBuilder().SetGzipLevel(0)
+
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(etagFilter)
+	ws.Route(ws.GET("/event/watch").To(func(request *restful.Request, response *restful.Response) {
+		flusher, ok := response.ResponseWriter.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the response writer to implement http.Flusher")
+		}
+		response.AddHeader("Content-Type", "text/event-stream")
+		response.WriteHeader(http.StatusOK)
+		response.Write([]byte("data: event\n\n"))
+		flusher.Flush()
+	}))
+	container := restful.NewContainer()
+	container.Filter(gzipFilter)
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/event/watch", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if !recorder.Flushed {
+		t.Error("Expected the event frame to be flushed to the underlying connection")
+	}
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "" {
+		t.Errorf("Unexpected Content-Encoding %q on the event stream, expected none", encoding)
+	}
+	if etag := recorder.Header().Get("ETag"); etag != "" {
+		t.Errorf("Unexpected ETag %q on the event stream, expected none", etag)
+	}
+	if recorder.Body.String() != "data: event\n\n" {
+		t.Errorf("Unexpected body %q on the event stream", recorder.Body.String())
+	}
+}
+
+func TestGzipFilterDisabled(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("/large").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeaderAndEntity(http.StatusOK,
+			map[string]string{"key": strings.Repeat("value", 1024)})
+	}))
+	container := restful.NewContainer()
+	container.Filter(gzipFilter)
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/large", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code: %d, expected %d", recorder.Code, http.StatusOK)
+	}
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "" {
+		t.Errorf("Unexpected Content-Encoding %q with gzip-level 0, expected none", encoding)
+	}
+}
+
+func TestMaxBodySizeFilter(t *testing.T) {
+	args.GetHolderBuilder().SetMaxRequestBody(16)
+	defer args.GetHolderBuilder().SetMaxRequestBody(0)
+
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(maxBodySizeFilter)
+	ws.Route(ws.POST("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeader(http.StatusCreated)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/test",
+		strings.NewReader(strings.Repeat("x", 1024)))
+	request.Header.Set("Content-Type", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Unexpected status code for oversized body: %d, expected %d", recorder.Code,
+			http.StatusRequestEntityTooLarge)
+	}
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/test", strings.NewReader("{}"))
+	request.Header.Set("Content-Type", restful.MIME_JSON)
+	recorder = httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("Unexpected status code for small body: %d, expected %d", recorder.Code,
+			http.StatusCreated)
+	}
+}
+
+// countingReader counts the bytes consumed from a request body.
+type countingReader struct {
+	reader io.Reader
+	read   int
+}
+
+func (reader *countingReader) Read(data []byte) (int, error) {
+	n, err := reader.reader.Read(data)
+	reader.read += n
+	return n, err
+}
+
+func TestMaxBodySizeFilterChunkedBody(t *testing.T) {
+	args.GetHolderBuilder().SetMaxRequestBody(16)
+	defer args.GetHolderBuilder().SetMaxRequestBody(0)
+
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	// The installed order matters: the request logger reads the whole body, so the size
+	// limit has to be applied first.
+	ws.Filter(maxBodySizeFilter)
+	ws.Filter(requestAndResponseLogger)
+	ws.Route(ws.POST("/test").To(func(request *restful.Request, response *restful.Response) {
+		if _, err := ioutil.ReadAll(request.Request.Body); err != nil {
+			response.WriteErrorString(http.StatusRequestEntityTooLarge, err.Error()+"\n")
+			return
+		}
+		response.WriteHeader(http.StatusCreated)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := &countingReader{reader: strings.NewReader(strings.Repeat("x", 1024))}
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/test", body)
+	// A chunked body does not declare its length up front.
+	request.ContentLength = -1
+	request.Header.Set("Content-Type", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Unexpected status code for oversized chunked body: %d, expected %d",
+			recorder.Code, http.StatusRequestEntityTooLarge)
+	}
+	if body.read > 32 {
+		t.Errorf("Read %d bytes of a chunked body with a 16 byte limit, expected the read to "+
+			"be capped", body.read)
+	}
+}
+
+func TestRecoverPanicFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(recoverPanicFilter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		var pods []string
+		_ = pods[0]
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("Unexpected status code for panicking handler: %d, expected %d", recorder.Code,
+			http.StatusInternalServerError)
+	}
+
+	entity := recoveredPanicResponse{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &entity); err != nil {
+		t.Fatalf("Cannot parse panic response %q: %s", recorder.Body.String(), err)
+	}
+	if entity.RequestID == "" {
+		t.Error("Expected a request id in the panic response, got none")
+	}
+}
+
+func TestRequestIDFilter(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Filter(requestIDFilter)
+	ws.Route(ws.GET("/test").To(func(request *restful.Request, response *restful.Response) {
+		response.WriteHeaderAndEntity(http.StatusOK, map[string]string{"id": requestID(request)})
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if id := recorder.Header().Get("X-Request-ID"); id == "" {
+		t.Error("Expected a generated X-Request-ID header on the response, got none")
+	}
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	request.Header.Set("Accept", restful.MIME_JSON)
+	request.Header.Set("X-Request-ID", "incoming-id")
+	recorder = httptest.NewRecorder()
+	container.ServeHTTP(recorder, request)
+
+	if id := recorder.Header().Get("X-Request-ID"); id != "incoming-id" {
+		t.Errorf("Unexpected X-Request-ID header %q, expected the incoming id to be preserved", id)
+	}
+	if !strings.Contains(recorder.Body.String(), "incoming-id") {
+		t.Errorf("Request id was not stored in the request attributes, got body %s",
+			recorder.Body.String())
+	}
+}
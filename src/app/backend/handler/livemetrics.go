@@ -0,0 +1,150 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	restful "github.com/emicklei/go-restful"
+	"github.com/gorilla/websocket"
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// defaultLiveMetricsInterval is how often datapoints are pushed when the client does not
+// request a different interval.
+const defaultLiveMetricsInterval = 5 * time.Second
+
+// LiveMetricsFrame is a single datapoint pushed over the live metrics WebSocket. Usage values
+// are null when metrics for the resource are not available.
+type LiveMetricsFrame struct {
+	// Time the datapoint was collected.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Most recent CPU usage in millicores.
+	CPUUsage *uint64 `json:"cpuUsage"`
+
+	// Most recent memory usage in bytes.
+	MemoryUsage *uint64 `json:"memoryUsage"`
+}
+
+var liveMetricsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// liveMetricSource produces the most recent CPU and memory usage of a resource. It is a
+// function so that tests can substitute a fake source.
+type liveMetricSource func() (cpu, memory *uint64)
+
+// podMetricSource polls the metric client for the most recent CPU and memory usage of a pod.
+// Missing metrics result in null values rather than an error.
+func podMetricSource(metricClient metricapi.MetricClient, namespace, podName string) liveMetricSource {
+	selector := metricapi.ResourceSelector{
+		Namespace:    namespace,
+		ResourceType: api.ResourceKindPod,
+		ResourceName: podName,
+	}
+
+	return func() (cpu, memory *uint64) {
+		if metricClient == nil {
+			return nil, nil
+		}
+
+		metrics, err := metricClient.DownloadMetrics([]metricapi.ResourceSelector{selector},
+			[]string{metricapi.CpuUsage, metricapi.MemoryUsage},
+			metricapi.NoResourceCache).GetMetrics()
+		if err != nil {
+			log.Printf("Skipping live metrics datapoint because of error: %s", err)
+			return nil, nil
+		}
+
+		for _, metric := range metrics {
+			if len(metric.MetricPoints) == 0 {
+				continue
+			}
+			latest := metric.MetricPoints[len(metric.MetricPoints)-1].Value
+
+			switch metric.MetricName {
+			case metricapi.CpuUsage:
+				cpu = &latest
+			case metricapi.MemoryUsage:
+				memory = &latest
+			}
+		}
+		return
+	}
+}
+
+// serveLiveMetrics pushes a frame from the given source on every interval tick until the
+// client disconnects or a write fails.
+func serveLiveMetrics(conn *websocket.Conn, source liveMetricSource, interval time.Duration) {
+	defer conn.Close()
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				close(done)
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		cpu, memory := source()
+		frame := LiveMetricsFrame{Timestamp: time.Now(), CPUUsage: cpu, MemoryUsage: memory}
+		if err := conn.WriteJSON(frame); err != nil {
+			return
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (apiHandler *APIHandler) handleLivePodMetrics(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	podName := request.PathParameter("pod")
+
+	interval := defaultLiveMetricsInterval
+	if raw := request.QueryParameter("interval"); len(raw) > 0 {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds < 1 {
+			response.AddHeader("Content-Type", "text/plain")
+			response.WriteErrorString(http.StatusBadRequest,
+				"interval must be a positive number of seconds\n")
+			return
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	conn, err := liveMetricsUpgrader.Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Printf("Cannot upgrade live metrics connection: %s", err)
+		return
+	}
+
+	serveLiveMetrics(conn, podMetricSource(apiHandler.iManager.Metric().Client(), namespace,
+		podName), interval)
+}
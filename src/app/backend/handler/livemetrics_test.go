@@ -0,0 +1,63 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestServeLiveMetrics(t *testing.T) {
+	cpu, memory := uint64(100), uint64(1024)
+	source := func() (*uint64, *uint64) {
+		return &cpu, &memory
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := liveMetricsUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Fatalf("Cannot upgrade connection: %s", err)
+		}
+		serveLiveMetrics(conn, source, 10*time.Millisecond)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Cannot connect to live metrics server: %s", err)
+	}
+	defer conn.Close()
+
+	for i := 0; i < 3; i++ {
+		frame := LiveMetricsFrame{}
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		if err := conn.ReadJSON(&frame); err != nil {
+			t.Fatalf("Cannot read frame %d: %s", i, err)
+		}
+
+		if frame.CPUUsage == nil || *frame.CPUUsage != cpu {
+			t.Errorf("Frame %d CPUUsage == %v, expected %d", i, frame.CPUUsage, cpu)
+		}
+		if frame.MemoryUsage == nil || *frame.MemoryUsage != memory {
+			t.Errorf("Frame %d MemoryUsage == %v, expected %d", i, frame.MemoryUsage, memory)
+		}
+	}
+}
@@ -44,16 +44,28 @@ type Translation struct {
 // based on the Accept-Language header.
 type LocaleHandler struct {
 	SupportedLocales []language.Tag
+
+	// AssetsDir overrides the directory the frontend assets are served from. When empty,
+	// assets are served from the directory of the dashboard binary.
+	AssetsDir string
+
+	// DefaultLocale is served when no supported locale matches the request. When empty, the
+	// English bundle is the default.
+	DefaultLocale string
 }
 
-// CreateLocaleHandler loads the localization configuration and constructs a LocaleHandler.
-func CreateLocaleHandler() *LocaleHandler {
+// CreateLocaleHandler loads the localization configuration and constructs a LocaleHandler
+// serving assets from the given directory, or from the directory of the dashboard binary
+// when it is empty. Requests that match none of the available locales are served the given
+// default locale.
+func CreateLocaleHandler(assetsDir, defaultLocale string) *LocaleHandler {
 	locales, err := getSupportedLocales("./locale_conf.json")
 	if err != nil {
 		glog.Warningf("Error when loading the localization configuration. Dashboard will not be localized. %s", err)
 		locales = []language.Tag{}
 	}
-	return &LocaleHandler{SupportedLocales: locales}
+	return &LocaleHandler{SupportedLocales: locales, AssetsDir: assetsDir,
+		DefaultLocale: defaultLocale}
 }
 
 func getSupportedLocales(configFile string) ([]language.Tag, error) {
@@ -108,7 +120,12 @@ func (handler *LocaleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		// we want a different index.html (for the right locale) to be served when the page refreshes.
 		w.Header().Add("Cache-Control", "no-store")
 	}
-	acceptLanguage := os.Getenv("ACCEPT_LANGUAGE")
+	// The lng query parameter overrides any negotiated locale, so users can pin a
+	// translation regardless of their browser settings.
+	acceptLanguage := r.URL.Query().Get("lng")
+	if acceptLanguage == "" {
+		acceptLanguage = os.Getenv("ACCEPT_LANGUAGE")
+	}
 	if acceptLanguage == "" {
 		acceptLanguage = r.Header.Get("Accept-Language")
 	}
@@ -117,8 +134,15 @@ func (handler *LocaleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
 func (handler *LocaleHandler) determineLocalizedDir(locale string) string {
-	assetsDir := getAssetsDir()
-	defaultDir := filepath.Join(assetsDir, defaultLocaleDir)
+	assetsDir := handler.AssetsDir
+	if assetsDir == "" {
+		assetsDir = getAssetsDir()
+	}
+	defaultLocale := handler.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = defaultLocaleDir
+	}
+	defaultDir := filepath.Join(assetsDir, defaultLocale)
 	tags, _, err := language.ParseAcceptLanguage(locale)
 	if (err != nil) || (len(tags) == 0) {
 		return defaultDir
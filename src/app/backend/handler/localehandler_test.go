@@ -17,6 +17,8 @@ package handler
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -214,3 +216,51 @@ func TestDetermineLocale(t *testing.T) {
 		}()
 	}
 }
+
+func TestLocaleHandlerServeHTTP(t *testing.T) {
+	assetsDir, err := ioutil.TempDir("", "test-locale-assets")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer os.RemoveAll(assetsDir)
+
+	bundles := map[string]string{"en": "english shell", "zh": "chinese shell"}
+	for locale, content := range bundles {
+		if err := os.Mkdir(filepath.Join(assetsDir, locale), 0777); err != nil {
+			t.Fatalf("%s", err)
+		}
+		err := ioutil.WriteFile(filepath.Join(assetsDir, locale, "index.html"),
+			[]byte(content), 0644)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+	}
+
+	handler := &LocaleHandler{
+		SupportedLocales: languageMake([]string{"en", "zh"}),
+		AssetsDir:        assetsDir,
+	}
+
+	cases := []struct {
+		info, url, acceptLanguage, expected string
+	}{
+		{"Accept-Language header selects the Chinese bundle", "/", "zh", "chinese shell"},
+		{"unsupported locale falls back to the default bundle", "/", "de", "english shell"},
+		{"lng parameter overrides the Accept-Language header", "/?lng=zh", "en", "chinese shell"},
+		{"missing header serves the default bundle", "/", "", "english shell"},
+	}
+
+	for _, c := range cases {
+		request := httptest.NewRequest(http.MethodGet, c.url, nil)
+		if c.acceptLanguage != "" {
+			request.Header.Set("Accept-Language", c.acceptLanguage)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		if recorder.Body.String() != c.expected {
+			t.Errorf("GET %s with Accept-Language %q returned body %q, expected %q (%s)",
+				c.url, c.acceptLanguage, recorder.Body.String(), c.expected, c.info)
+		}
+	}
+}
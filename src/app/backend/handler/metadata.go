@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MetadataUpdateSpec describes label and annotation changes to apply to a resource.
+type MetadataUpdateSpec struct {
+	// Labels to add or update, keyed by label name.
+	AddLabels map[string]string `json:"addLabels"`
+
+	// Names of labels to remove.
+	RemoveLabels []string `json:"removeLabels"`
+
+	// Annotations to add or update, keyed by annotation name.
+	AddAnnotations map[string]string `json:"addAnnotations"`
+
+	// Names of annotations to remove.
+	RemoveAnnotations []string `json:"removeAnnotations"`
+}
+
+// protectedLabels are built-in labels managed by controllers. Editing them detaches objects
+// from their owners or breaks selectors, so metadata updates reject them.
+var protectedLabels = []string{
+	"pod-template-hash",
+	"pod-template-generation",
+	"controller-revision-hash",
+	"controller-uid",
+	"job-name",
+	"statefulset.kubernetes.io/pod-name",
+}
+
+// isProtectedLabel checks whether the label is managed by Kubernetes and must not be edited
+// through the metadata update endpoint.
+func isProtectedLabel(label string) bool {
+	for _, protected := range protectedLabels {
+		if label == protected {
+			return true
+		}
+	}
+	return strings.Contains(label, "kubernetes.io/")
+}
+
+// toMergePatch converts the metadata update spec into a JSON merge patch that adds the
+// requested labels and annotations and removes the listed ones by setting them to null.
+func (spec *MetadataUpdateSpec) toMergePatch() ([]byte, error) {
+	labels := make(map[string]interface{})
+	for label, value := range spec.AddLabels {
+		if isProtectedLabel(label) {
+			return nil, fmt.Errorf("Label %s is managed by Kubernetes and cannot be edited", label)
+		}
+		labels[label] = value
+	}
+	for _, label := range spec.RemoveLabels {
+		if isProtectedLabel(label) {
+			return nil, fmt.Errorf("Label %s is managed by Kubernetes and cannot be removed", label)
+		}
+		labels[label] = nil
+	}
+
+	annotations := make(map[string]interface{})
+	for annotation, value := range spec.AddAnnotations {
+		annotations[annotation] = value
+	}
+	for _, annotation := range spec.RemoveAnnotations {
+		annotations[annotation] = nil
+	}
+
+	metadata := make(map[string]interface{})
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+
+	return json.Marshal(map[string]interface{}{"metadata": metadata})
+}
@@ -0,0 +1,62 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMetadataUpdateSpecToMergePatch(t *testing.T) {
+	spec := &MetadataUpdateSpec{
+		AddLabels:         map[string]string{"environment": "staging"},
+		RemoveAnnotations: []string{"deprecated-annotation"},
+	}
+
+	patch, err := spec.toMergePatch()
+	if err != nil {
+		t.Fatalf("toMergePatch(%#v) returned error: %s", spec, err)
+	}
+
+	actual := make(map[string]interface{})
+	if err := json.Unmarshal(patch, &actual); err != nil {
+		t.Fatalf("toMergePatch(%#v) returned invalid JSON: %s", spec, err)
+	}
+
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels":      map[string]interface{}{"environment": "staging"},
+			"annotations": map[string]interface{}{"deprecated-annotation": nil},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toMergePatch(%#v) == \ngot %#v, \nexpected %#v", spec, actual, expected)
+	}
+}
+
+func TestMetadataUpdateSpecRejectsProtectedLabels(t *testing.T) {
+	cases := []*MetadataUpdateSpec{
+		{AddLabels: map[string]string{"pod-template-hash": "12345"}},
+		{RemoveLabels: []string{"statefulset.kubernetes.io/pod-name"}},
+		{AddLabels: map[string]string{"failure-domain.beta.kubernetes.io/zone": "us-east-1a"}},
+	}
+
+	for _, spec := range cases {
+		if _, err := spec.toMergePatch(); err == nil {
+			t.Errorf("toMergePatch(%#v) expected protected label error, got nil", spec)
+		}
+	}
+}
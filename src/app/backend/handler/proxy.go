@@ -0,0 +1,47 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"k8s.io/client-go/rest"
+)
+
+// proxiedServiceNamespace is the namespace the proxied services are expected to live in.
+const proxiedServiceNamespace = "kube-system"
+
+// proxiedServices is the whitelist of cluster services that GET requests may be proxied to
+// through the apiserver service proxy. Requests to any other service are rejected.
+var proxiedServices = map[string]bool{
+	"prometheus":   true,
+	"grafana":      true,
+	"alertmanager": true,
+}
+
+// isServiceProxied returns whether requests may be proxied to the given service.
+func isServiceProxied(service string) bool {
+	return proxiedServices[service]
+}
+
+// proxyServiceRequest performs a GET request against the given cluster service through the
+// apiserver service proxy and returns the raw response body.
+func proxyServiceRequest(client rest.Interface, service, path string) ([]byte, error) {
+	return client.Get().
+		Namespace(proxiedServiceNamespace).
+		Resource("services").
+		Name(service).
+		SubResource("proxy").
+		Suffix(path).
+		DoRaw()
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestIsServiceProxied(t *testing.T) {
+	cases := []struct {
+		service  string
+		expected bool
+	}{
+		{"prometheus", true},
+		{"grafana", true},
+		{"alertmanager", true},
+		{"kube-dns", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		actual := isServiceProxied(c.service)
+		if actual != c.expected {
+			t.Errorf("isServiceProxied(%#v) returns %#v, expected %#v", c.service, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestProxyServiceRequest(t *testing.T) {
+	requestedPath := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		w.Write([]byte("proxied response"))
+	}))
+	defer server.Close()
+
+	k8sClient, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	data, err := proxyServiceRequest(k8sClient.CoreV1().RESTClient(), "prometheus", "api/v1/query")
+	if err != nil {
+		t.Fatalf("proxyServiceRequest(client, prometheus, api/v1/query) returned error: %s", err)
+	}
+
+	expectedPath := "/api/v1/namespaces/kube-system/services/prometheus/proxy/api/v1/query"
+	if requestedPath != expectedPath {
+		t.Errorf("proxyServiceRequest(client, prometheus, api/v1/query) requested %s, expected %s",
+			requestedPath, expectedPath)
+	}
+
+	if string(data) != "proxied response" {
+		t.Errorf("proxyServiceRequest(client, prometheus, api/v1/query) returned %s, expected %s",
+			string(data), "proxied response")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// CreateSpaFallbackHandler wraps the given static asset handler so that requests for
+// application routes are rewritten to "/" and serve the SPA shell index.html. Without the
+// fallback, deep links into the application 404 on a page refresh, because no asset matches
+// the route path.
+func CreateSpaFallbackHandler(assets http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isSpaRoute(r.URL.EscapedPath()) {
+			r.URL.Path = "/"
+		}
+		assets.ServeHTTP(w, r)
+	})
+}
+
+// isSpaRoute tells whether the path belongs to the single page application router: it is not
+// an API call and its last segment carries no file extension, so it cannot point at an asset.
+func isSpaRoute(urlPath string) bool {
+	if urlPath == "/api" || strings.HasPrefix(urlPath, "/api/") {
+		return false
+	}
+	return path.Ext(urlPath) == ""
+}
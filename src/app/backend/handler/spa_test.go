@@ -0,0 +1,69 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSpaFallbackHandler(t *testing.T) {
+	assetsDir, err := ioutil.TempDir("", "test-spa-assets")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	defer os.RemoveAll(assetsDir)
+
+	spaShell := "<html>spa shell</html>"
+	script := "console.log('app');"
+	if err := ioutil.WriteFile(filepath.Join(assetsDir, "index.html"), []byte(spaShell), 0644); err != nil {
+		t.Fatalf("%s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(assetsDir, "app.js"), []byte(script), 0644); err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	handler := CreateSpaFallbackHandler(http.FileServer(http.Dir(assetsDir)))
+
+	cases := []struct {
+		info, path     string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"application route serves the SPA shell", "/deploy/foo", http.StatusOK, spaShell},
+		{"existing asset is served directly", "/app.js", http.StatusOK, script},
+		{"paths with a file extension do not fall back", "/deploy/missing.js",
+			http.StatusNotFound, ""},
+		{"API paths are not rewritten", "/api/v1/pod", http.StatusNotFound, ""},
+	}
+
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, c.path, nil))
+
+		if recorder.Code != c.expectedStatus {
+			t.Errorf("GET %s returned status %d, expected %d (%s)", c.path, recorder.Code,
+				c.expectedStatus, c.info)
+		}
+		if c.expectedBody != "" && recorder.Body.String() != c.expectedBody {
+			t.Errorf("GET %s returned body %q, expected %q (%s)", c.path,
+				recorder.Body.String(), c.expectedBody, c.info)
+		}
+	}
+}
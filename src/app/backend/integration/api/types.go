@@ -23,7 +23,8 @@ type IntegrationID string
 
 // Integration app IDs should be registered in this block.
 const (
-	HeapsterIntegrationID IntegrationID = "heapster"
+	HeapsterIntegrationID      IntegrationID = "heapster"
+	MetricsServerIntegrationID IntegrationID = "metrics-server"
 )
 
 // Integration represents application integrated into the dashboard. Every application
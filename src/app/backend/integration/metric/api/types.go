@@ -110,6 +110,13 @@ type ResourceSelector struct {
 const (
 	CpuUsage    = "cpu/usage_rate"
 	MemoryUsage = "memory/usage"
+	// MemoryWorkingSet is the memory the kernel cannot reclaim. OOM decisions are based on
+	// it, so it is the number to watch when total usage looks high.
+	MemoryWorkingSet = "memory/working_set"
+	// MemoryRSS is the anonymous and swap cache memory of the resource.
+	MemoryRSS = "memory/rss"
+	// MemoryCache is the page cache memory of the resource, reclaimable under pressure.
+	MemoryCache = "memory/cache"
 )
 
 type DataPoints []DataPoint
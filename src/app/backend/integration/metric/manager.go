@@ -23,6 +23,7 @@ import (
 	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/integration/metric/heapster"
+	"github.com/kubernetes/dashboard/src/app/backend/integration/metric/metricsserver"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -42,6 +43,8 @@ type MetricManager interface {
 	List() []integrationapi.Integration
 	// ConfigureHeapster configures and adds heapster to clients list.
 	ConfigureHeapster(host string) MetricManager
+	// ConfigureMetricsServer configures and adds a metrics-server client to clients list.
+	ConfigureMetricsServer() MetricManager
 }
 
 // Implements MetricManager interface.
@@ -92,7 +95,11 @@ func (self *metricManager) EnableWithRetry(id integrationapi.IntegrationID, peri
 
 		err := metricClient.HealthCheck()
 		if err != nil {
-			self.active = nil
+			// Deactivate only own client, so a healthy fallback backend enabled by
+			// another retry loop is not switched off.
+			if self.active == metricClient {
+				self.active = nil
+			}
 			log.Printf("Metric client health check failed: %s. Retrying in %d seconds.", err, period)
 			return
 		}
@@ -127,6 +134,20 @@ func (self *metricManager) ConfigureHeapster(host string) MetricManager {
 	return self
 }
 
+// ConfigureMetricsServer implements metric manager interface. See MetricManager for more
+// information.
+func (self *metricManager) ConfigureMetricsServer() MetricManager {
+	kubeClient := self.manager.InsecureClient()
+	metricClient, err := metricsserver.CreateMetricsServerClient(kubeClient)
+	if err != nil {
+		log.Printf("There was an error during metrics-server client creation: %s", err.Error())
+		return self
+	}
+
+	self.clients[metricClient.ID()] = metricClient
+	return self
+}
+
 // NewMetricManager creates metric manager.
 func NewMetricManager(manager clientapi.ClientManager) MetricManager {
 	return &metricManager{
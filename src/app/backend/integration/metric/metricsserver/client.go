@@ -0,0 +1,244 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/integration/metric/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// metricsServerClient reads instantaneous pod and node usage from the resource metrics API
+// (metrics.k8s.io) served by metrics-server. Implements MetricClient and Integration
+// interfaces.
+type metricsServerClient struct {
+	client MetricsServerRESTClient
+}
+
+// Implement Integration interface.
+
+// HealthCheck implements integration app interface. See Integration interface for more information.
+func (self metricsServerClient) HealthCheck() error {
+	if self.client == nil {
+		return errors.New("Metrics-server not configured")
+	}
+
+	return self.client.HealthCheck()
+}
+
+// ID implements integration app interface. See Integration interface for more information.
+func (self metricsServerClient) ID() integrationapi.IntegrationID {
+	return integrationapi.MetricsServerIntegrationID
+}
+
+// Implement MetricClient interface
+
+// DownloadMetrics implements metric client interface. See MetricClient for more information.
+func (self metricsServerClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.MetricPromises{}
+	for _, metricName := range metricNames {
+		collectedMetrics := self.DownloadMetric(selectors, metricName, cachedResources)
+		result = append(result, collectedMetrics...)
+	}
+	return result
+}
+
+// DownloadMetric implements metric client interface. See MetricClient for more information.
+// The resource metrics API serves a single usage sample per resource, so every metric holds
+// one data point.
+func (self metricsServerClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.NewMetricPromises(len(selectors))
+	for i, selector := range selectors {
+		go func(i int, selector metricapi.ResourceSelector) {
+			metric, err := self.downloadMetricForSelector(selector, metricName, cachedResources)
+			if err != nil {
+				result[i].Metric <- nil
+				result[i].Error <- err
+				return
+			}
+			result[i].Metric <- metric
+			result[i].Error <- nil
+		}(i, selector)
+	}
+	return result
+}
+
+// AggregateMetrics implements metric client interface. See MetricClient for more information.
+func (self metricsServerClient) AggregateMetrics(metrics metricapi.MetricPromises,
+	metricName string, aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return common.AggregateMetricPromises(metrics, metricName, aggregations, nil)
+}
+
+// downloadMetricForSelector reads the usage of a single resource. Pods and nodes are read
+// directly, derived resources (deployments etc.) are resolved to their pods first. Metrics
+// the resource metrics API cannot serve come back without samples, so callers can tell an
+// unreported metric from a measured zero.
+func (self metricsServerClient) downloadMetricForSelector(selector metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) (*metricapi.Metric, error) {
+	label := metricapi.Label{selector.ResourceType: []types.UID{selector.UID}}
+	if !isMetricSupported(metricName) {
+		return emptyMetric(metricName, label), nil
+	}
+
+	switch selector.ResourceType {
+	case api.ResourceKindNode:
+		rawNode, err := self.client.Get("nodes/" + selector.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+		node := nodeMetrics{}
+		if err := json.Unmarshal(rawNode, &node); err != nil {
+			return nil, err
+		}
+		return toMetric(usageValue(node.Usage, metricName), node.Timestamp, metricName, label), nil
+	case api.ResourceKindPod:
+		rawPod, err := self.client.Get("namespaces/" + selector.Namespace + "/pods/" +
+			selector.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+		pod := podMetrics{}
+		if err := json.Unmarshal(rawPod, &pod); err != nil {
+			return nil, err
+		}
+		return toMetric(podUsageValue(pod, metricName), pod.Timestamp, metricName, label), nil
+	default:
+		return self.downloadDerivedMetric(selector, metricName, cachedResources, label)
+	}
+}
+
+// downloadDerivedMetric sums the usage of the pods targeted by a derived resource, matched
+// against the cached pods by the selector labels.
+func (self metricsServerClient) downloadDerivedMetric(selector metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources,
+	label metricapi.Label) (*metricapi.Metric, error) {
+	if _, isDerived := metricapi.DerivedResources[selector.ResourceType]; !isDerived {
+		return nil, fmt.Errorf("Resource type %s is not supported by the metrics API",
+			selector.ResourceType)
+	}
+
+	targetPods := map[string]bool{}
+	for _, pod := range cachedResources.Pods {
+		if pod.Namespace == selector.Namespace &&
+			api.IsSelectorMatching(selector.Selector, pod.Labels) {
+			targetPods[pod.Name] = true
+		}
+	}
+
+	rawPods, err := self.client.Get("namespaces/" + selector.Namespace + "/pods")
+	if err != nil {
+		return nil, err
+	}
+	podList := podMetricsList{}
+	if err := json.Unmarshal(rawPods, &podList); err != nil {
+		return nil, err
+	}
+
+	value := int64(0)
+	timestamp := metaV1.Time{}
+	for _, pod := range podList.Items {
+		if !targetPods[pod.Name] {
+			continue
+		}
+		value += podUsageValue(pod, metricName)
+		if pod.Timestamp.After(timestamp.Time) {
+			timestamp = pod.Timestamp
+		}
+	}
+	return toMetric(value, timestamp, metricName, label), nil
+}
+
+// podUsageValue sums the usage of all containers of a pod.
+func podUsageValue(pod podMetrics, metricName string) int64 {
+	value := int64(0)
+	for _, container := range pod.Containers {
+		value += usageValue(container.Usage, metricName)
+	}
+	return value
+}
+
+// isMetricSupported tells whether the resource metrics API serves the metric. It reports a
+// single CPU value and a single memory value, the working set; other metrics, e.g. the RSS
+// and page cache memory subtypes, are only available from richer backends like heapster.
+func isMetricSupported(metricName string) bool {
+	switch metricName {
+	case metricapi.CpuUsage, metricapi.MemoryUsage, metricapi.MemoryWorkingSet:
+		return true
+	}
+	return false
+}
+
+// usageValue converts a measured usage to the unit used by the rest of the metric layer:
+// millicores for CPU and bytes for memory. Only called for supported metrics.
+func usageValue(usage v1.ResourceList, metricName string) int64 {
+	switch metricName {
+	case metricapi.CpuUsage:
+		quantity := usage[v1.ResourceCPU]
+		return quantity.MilliValue()
+	case metricapi.MemoryUsage, metricapi.MemoryWorkingSet:
+		// The resource metrics API reports a single memory value, the working set.
+		quantity := usage[v1.ResourceMemory]
+		return quantity.Value()
+	}
+	return 0
+}
+
+// toMetric wraps a single usage sample into the metric format used by the dashboard.
+func toMetric(value int64, timestamp metaV1.Time, metricName string,
+	label metricapi.Label) *metricapi.Metric {
+	return &metricapi.Metric{
+		DataPoints: metricapi.DataPoints{{X: timestamp.Unix(), Y: value}},
+		MetricPoints: []metricapi.MetricPoint{
+			{Timestamp: timestamp.Time, Value: uint64(value)},
+		},
+		MetricName: metricName,
+		Label:      label,
+	}
+}
+
+// emptyMetric reports a metric the resource metrics API cannot serve, without any samples.
+func emptyMetric(metricName string, label metricapi.Label) *metricapi.Metric {
+	return &metricapi.Metric{
+		DataPoints:   metricapi.DataPoints{},
+		MetricPoints: []metricapi.MetricPoint{},
+		MetricName:   metricName,
+		Label:        label,
+	}
+}
+
+// CreateMetricsServerClient creates a new metrics-server client reading from the aggregated
+// resource metrics API of the cluster apiserver.
+func CreateMetricsServerClient(k8sClient kubernetes.Interface) (metricapi.MetricClient, error) {
+	if k8sClient == nil {
+		return nil, errors.New("Kubernetes client is required to create a metrics-server client")
+	}
+
+	log.Print("Creating in-cluster metrics-server client")
+	return metricsServerClient{
+		client: inClusterMetricsServerClient{client: k8sClient.CoreV1().RESTClient()},
+	}, nil
+}
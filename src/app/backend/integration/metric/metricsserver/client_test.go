@@ -0,0 +1,127 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// fakeRESTClient serves canned metrics API responses keyed by request path.
+type fakeRESTClient struct {
+	responses map[string]string
+}
+
+func (self fakeRESTClient) Get(path string) ([]byte, error) {
+	response, found := self.responses[path]
+	if !found {
+		return nil, fmt.Errorf("unexpected metrics API path: %s", path)
+	}
+	return []byte(response), nil
+}
+
+func (self fakeRESTClient) HealthCheck() error {
+	return nil
+}
+
+func TestDownloadMetricForPod(t *testing.T) {
+	client := metricsServerClient{client: fakeRESTClient{responses: map[string]string{
+		"namespaces/ns-1/pods/pod-1": `{
+			"metadata": {"name": "pod-1", "namespace": "ns-1"},
+			"timestamp": "2018-01-01T12:00:00Z",
+			"containers": [
+				{"name": "app", "usage": {"cpu": "100m", "memory": "64Mi"}},
+				{"name": "sidecar", "usage": {"cpu": "50m", "memory": "16Mi"}}
+			]
+		}`,
+	}}}
+	selectors := []metricapi.ResourceSelector{
+		{Namespace: "ns-1", ResourceType: api.ResourceKindPod, ResourceName: "pod-1"},
+	}
+
+	cases := []struct {
+		metricName string
+		expected   int64
+	}{
+		{metricapi.CpuUsage, 150},
+		{metricapi.MemoryUsage, 80 * 1024 * 1024},
+	}
+
+	for _, c := range cases {
+		metrics, err := client.DownloadMetric(selectors, c.metricName,
+			metricapi.NoResourceCache).GetMetrics()
+		if err != nil {
+			t.Fatalf("DownloadMetric(pod-1, %s) returned error: %s", c.metricName, err)
+		}
+		if len(metrics) != 1 || len(metrics[0].DataPoints) != 1 {
+			t.Fatalf("DownloadMetric(pod-1, %s) == %+v, expected a single data point",
+				c.metricName, metrics)
+		}
+		if metrics[0].DataPoints[0].Y != c.expected {
+			t.Errorf("DownloadMetric(pod-1, %s) == %d, expected %d", c.metricName,
+				metrics[0].DataPoints[0].Y, c.expected)
+		}
+	}
+}
+
+func TestDownloadMetricUnsupportedByResourceMetricsAPI(t *testing.T) {
+	// The empty response map makes any API call fail, proving unsupported metrics are
+	// answered without one.
+	client := metricsServerClient{client: fakeRESTClient{responses: map[string]string{}}}
+	selectors := []metricapi.ResourceSelector{
+		{Namespace: "ns-1", ResourceType: api.ResourceKindPod, ResourceName: "pod-1"},
+	}
+
+	for _, metricName := range []string{metricapi.MemoryRSS, metricapi.MemoryCache} {
+		metrics, err := client.DownloadMetric(selectors, metricName,
+			metricapi.NoResourceCache).GetMetrics()
+		if err != nil {
+			t.Fatalf("DownloadMetric(pod-1, %s) returned error: %s", metricName, err)
+		}
+		if len(metrics) != 1 {
+			t.Fatalf("DownloadMetric(pod-1, %s) == %+v, expected a single metric", metricName,
+				metrics)
+		}
+		if len(metrics[0].DataPoints) != 0 || len(metrics[0].MetricPoints) != 0 {
+			t.Errorf("DownloadMetric(pod-1, %s) == %+v, expected no samples for a metric the "+
+				"resource metrics API cannot serve", metricName, metrics[0])
+		}
+	}
+}
+
+func TestDownloadMetricForNode(t *testing.T) {
+	client := metricsServerClient{client: fakeRESTClient{responses: map[string]string{
+		"nodes/node-1": `{
+			"metadata": {"name": "node-1"},
+			"timestamp": "2018-01-01T12:00:00Z",
+			"usage": {"cpu": "2", "memory": "1Gi"}
+		}`,
+	}}}
+	selectors := []metricapi.ResourceSelector{
+		{ResourceType: api.ResourceKindNode, ResourceName: "node-1"},
+	}
+
+	metrics, err := client.DownloadMetric(selectors, metricapi.CpuUsage,
+		metricapi.NoResourceCache).GetMetrics()
+	if err != nil {
+		t.Fatalf("DownloadMetric(node-1, cpu) returned error: %s", err)
+	}
+	if len(metrics) != 1 || metrics[0].DataPoints[0].Y != 2000 {
+		t.Errorf("DownloadMetric(node-1, cpu) == %+v, expected 2000 millicores", metrics)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsserver
+
+import (
+	"k8s.io/client-go/rest"
+)
+
+// metricsAPIPath is the root of the resource metrics API served by metrics-server through
+// apiserver aggregation.
+const metricsAPIPath = "/apis/metrics.k8s.io/v1beta1/"
+
+// MetricsServerRESTClient is used to make raw requests to the resource metrics API.
+// Separation is done to allow testing.
+type MetricsServerRESTClient interface {
+	// Get performs a GET request to the metrics API path given without the API prefix, e.g.,
+	// namespaces/default/pods/foo, and returns the raw response.
+	Get(path string) ([]byte, error)
+	HealthCheck() error
+}
+
+// inClusterMetricsServerClient talks to metrics-server through the aggregated API of the
+// cluster apiserver.
+type inClusterMetricsServerClient struct {
+	client rest.Interface
+}
+
+// Get performs a request to the given metrics API path.
+func (self inClusterMetricsServerClient) Get(path string) ([]byte, error) {
+	return self.client.Get().AbsPath(metricsAPIPath + path).DoRaw()
+}
+
+// HealthCheck does a health check of the application. Returns nil if the metrics API can be
+// reached, error object otherwise.
+func (self inClusterMetricsServerClient) HealthCheck() error {
+	_, err := self.client.Get().AbsPath(metricsAPIPath).DoRaw()
+	return err
+}
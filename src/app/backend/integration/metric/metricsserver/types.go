@@ -0,0 +1,59 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsserver
+
+import (
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Structures below mirror the objects served by the metrics.k8s.io/v1beta1 API. The API types
+// are not vendored, so the wire format is declared here.
+
+// podMetrics is the usage sample of a single pod as reported by metrics-server.
+type podMetrics struct {
+	metaV1.ObjectMeta `json:"metadata"`
+
+	// Timestamp of the usage sample.
+	Timestamp metaV1.Time `json:"timestamp"`
+
+	// Containers holds one usage entry per container of the pod.
+	Containers []containerMetrics `json:"containers"`
+}
+
+// containerMetrics is the usage sample of a single container.
+type containerMetrics struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Usage holds the measured resource usage, keyed by resource name.
+	Usage v1.ResourceList `json:"usage"`
+}
+
+// podMetricsList is a list of pod usage samples.
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+// nodeMetrics is the usage sample of a single node as reported by metrics-server.
+type nodeMetrics struct {
+	metaV1.ObjectMeta `json:"metadata"`
+
+	// Timestamp of the usage sample.
+	Timestamp metaV1.Time `json:"timestamp"`
+
+	// Usage holds the measured resource usage, keyed by resource name.
+	Usage v1.ResourceList `json:"usage"`
+}
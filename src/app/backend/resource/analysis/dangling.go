@@ -0,0 +1,216 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// DanglingResources lists resources in a namespace that are not referenced by anything and
+// are therefore candidates for cleanup.
+type DanglingResources struct {
+	// Config maps not mounted or referenced from any pod.
+	ConfigMaps []api.ObjectMeta `json:"configMaps"`
+
+	// Secrets not mounted or referenced from any pod. Service account token secrets are
+	// referenced implicitly and excluded.
+	Secrets []api.ObjectMeta `json:"secrets"`
+
+	// Persistent volume claims not mounted by any pod.
+	PersistentVolumeClaims []api.ObjectMeta `json:"persistentVolumeClaims"`
+
+	// Services whose endpoints contain no ready addresses.
+	Services []api.ObjectMeta `json:"services"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// FindDangling returns resources of the given namespace that nothing references: config maps,
+// secrets and persistent volume claims not used by any pod, and services with no ready
+// endpoints.
+func FindDangling(client client.Interface, nsQuery *common.NamespaceQuery) (*DanglingResources, error) {
+	log.Print("Looking for dangling resources")
+
+	channels := &common.ResourceChannels{
+		PodList:                   common.GetPodListChannel(client, nsQuery, 1),
+		ConfigMapList:             common.GetConfigMapListChannel(client, nsQuery, 1),
+		SecretList:                common.GetSecretListChannel(client, nsQuery, 1),
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, nsQuery, 1),
+		ServiceList:               common.GetServiceListChannel(client, nsQuery, 1),
+		EndpointList:              common.GetEndpointListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	result := &DanglingResources{
+		ConfigMaps:             make([]api.ObjectMeta, 0),
+		Secrets:                make([]api.ObjectMeta, 0),
+		PersistentVolumeClaims: make([]api.ObjectMeta, 0),
+		Services:               make([]api.ObjectMeta, 0),
+		Errors:                 make([]error, 0),
+	}
+
+	configMapRefs, secretRefs, claimRefs := referencedByPods(pods.Items)
+
+	configMaps := <-channels.ConfigMapList.List
+	err := <-channels.ConfigMapList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	} else if err == nil {
+		for _, configMap := range configMaps.Items {
+			if !configMapRefs[configMap.Namespace+"/"+configMap.Name] {
+				result.ConfigMaps = append(result.ConfigMaps, api.NewObjectMeta(configMap.ObjectMeta))
+			}
+		}
+	}
+
+	secrets := <-channels.SecretList.List
+	err = <-channels.SecretList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	} else if err == nil {
+		for _, secret := range secrets.Items {
+			if secret.Type == v1.SecretTypeServiceAccountToken {
+				continue
+			}
+			if !secretRefs[secret.Namespace+"/"+secret.Name] {
+				result.Secrets = append(result.Secrets, api.NewObjectMeta(secret.ObjectMeta))
+			}
+		}
+	}
+
+	claims := <-channels.PersistentVolumeClaimList.List
+	err = <-channels.PersistentVolumeClaimList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	} else if err == nil {
+		for _, claim := range claims.Items {
+			if !claimRefs[claim.Namespace+"/"+claim.Name] {
+				result.PersistentVolumeClaims = append(result.PersistentVolumeClaims,
+					api.NewObjectMeta(claim.ObjectMeta))
+			}
+		}
+	}
+
+	services := <-channels.ServiceList.List
+	err = <-channels.ServiceList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	endpoints := <-channels.EndpointList.List
+	endpointsErr := <-channels.EndpointList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(endpointsErr, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	if err == nil && endpointsErr == nil {
+		readyAddresses := map[string]int{}
+		for _, endpoint := range endpoints.Items {
+			for _, subset := range endpoint.Subsets {
+				readyAddresses[endpoint.Namespace+"/"+endpoint.Name] += len(subset.Addresses)
+			}
+		}
+		for _, service := range services.Items {
+			// Services without a selector (e.g. ExternalName) manage no endpoints.
+			if len(service.Spec.Selector) == 0 {
+				continue
+			}
+			if readyAddresses[service.Namespace+"/"+service.Name] == 0 {
+				result.Services = append(result.Services, api.NewObjectMeta(service.ObjectMeta))
+			}
+		}
+	}
+
+	result.Errors = nonCriticalErrors
+	return result, nil
+}
+
+// referencedByPods collects the config maps, secrets and persistent volume claims referenced
+// by the given pods through volumes, environment variables, envFrom sources and image pull
+// secrets, keyed by namespace/name.
+func referencedByPods(pods []v1.Pod) (configMaps, secrets, claims map[string]bool) {
+	configMaps = map[string]bool{}
+	secrets = map[string]bool{}
+	claims = map[string]bool{}
+
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.ConfigMap != nil {
+				configMaps[pod.Namespace+"/"+volume.ConfigMap.Name] = true
+			}
+			if volume.Secret != nil {
+				secrets[pod.Namespace+"/"+volume.Secret.SecretName] = true
+			}
+			if volume.PersistentVolumeClaim != nil {
+				claims[pod.Namespace+"/"+volume.PersistentVolumeClaim.ClaimName] = true
+			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.ConfigMap != nil {
+						configMaps[pod.Namespace+"/"+source.ConfigMap.Name] = true
+					}
+					if source.Secret != nil {
+						secrets[pod.Namespace+"/"+source.Secret.Name] = true
+					}
+				}
+			}
+		}
+
+		for _, pullSecret := range pod.Spec.ImagePullSecrets {
+			secrets[pod.Namespace+"/"+pullSecret.Name] = true
+		}
+
+		containers := append(pod.Spec.Containers, pod.Spec.InitContainers...)
+		for _, container := range containers {
+			for _, env := range container.Env {
+				if env.ValueFrom == nil {
+					continue
+				}
+				if env.ValueFrom.ConfigMapKeyRef != nil {
+					configMaps[pod.Namespace+"/"+env.ValueFrom.ConfigMapKeyRef.Name] = true
+				}
+				if env.ValueFrom.SecretKeyRef != nil {
+					secrets[pod.Namespace+"/"+env.ValueFrom.SecretKeyRef.Name] = true
+				}
+			}
+			for _, envFrom := range container.EnvFrom {
+				if envFrom.ConfigMapRef != nil {
+					configMaps[pod.Namespace+"/"+envFrom.ConfigMapRef.Name] = true
+				}
+				if envFrom.SecretRef != nil {
+					secrets[pod.Namespace+"/"+envFrom.SecretRef.Name] = true
+				}
+			}
+		}
+	}
+
+	return configMaps, secrets, claims
+}
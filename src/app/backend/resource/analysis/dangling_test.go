@@ -0,0 +1,65 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFindDangling(t *testing.T) {
+	namespace := "test-namespace"
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "config",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: v1.LocalObjectReference{Name: "referenced-config"},
+						},
+					},
+				},
+			},
+			Containers: []v1.Container{{Name: "test-container", Image: "test-image"}},
+		},
+	}
+	referencedConfigMap := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "referenced-config", Namespace: namespace},
+	}
+	unreferencedConfigMap := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unreferenced-config", Namespace: namespace},
+	}
+
+	fakeClient := fake.NewSimpleClientset(pod, referencedConfigMap, unreferencedConfigMap)
+	actual, err := FindDangling(fakeClient, common.NewSameNamespaceQuery(namespace))
+	if err != nil {
+		t.Fatalf("FindDangling(client, %s) returned error: %s", namespace, err)
+	}
+
+	if len(actual.ConfigMaps) != 1 {
+		t.Fatalf("FindDangling(client, %s) returned %d dangling config maps, expected 1",
+			namespace, len(actual.ConfigMaps))
+	}
+	if actual.ConfigMaps[0].Name != "unreferenced-config" {
+		t.Errorf("FindDangling(client, %s) returned dangling config map %s, expected %s",
+			namespace, actual.ConfigMaps[0].Name, "unreferenced-config")
+	}
+}
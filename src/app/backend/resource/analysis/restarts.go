@@ -0,0 +1,131 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// unknownRestartReason groups containers whose last termination state carries no reason.
+const unknownRestartReason = "Unknown"
+
+// ContainerRestarts identifies a single container with a nonzero restart count.
+type ContainerRestarts struct {
+	// Name of the pod the container belongs to.
+	Pod string `json:"pod"`
+
+	// Namespace of the pod the container belongs to.
+	Namespace string `json:"namespace"`
+
+	// Name of the container.
+	Container string `json:"container"`
+
+	// Number of times the container has restarted.
+	RestartCount int32 `json:"restartCount"`
+}
+
+// RestartReasonGroup aggregates restarted containers that share a last termination reason,
+// e.g. OOMKilled or Error.
+type RestartReasonGroup struct {
+	// Reason of the last termination of the grouped containers.
+	Reason string `json:"reason"`
+
+	// Sum of restart counts of the grouped containers.
+	RestartCount int32 `json:"restartCount"`
+
+	// Restarted containers with this termination reason, sorted by restart count.
+	Containers []ContainerRestarts `json:"containers"`
+}
+
+// RestartAnalysis lists containers with nonzero restart counts grouped by the reason of their
+// last termination, surfacing systemic problems like OOM kills across a namespace.
+type RestartAnalysis struct {
+	// Groups of restarted containers by last termination reason, sorted by restart count.
+	Reasons []RestartReasonGroup `json:"reasons"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetRestartAnalysis scans all pods of the given namespace and returns containers that have
+// restarted, grouped by the reason of their last termination.
+func GetRestartAnalysis(client client.Interface, nsQuery *common.NamespaceQuery) (
+	*RestartAnalysis, error) {
+	log.Print("Analyzing container restarts")
+
+	channels := &common.ResourceChannels{
+		PodList: common.GetPodListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	err := <-channels.PodList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	groups := map[string]*RestartReasonGroup{}
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.RestartCount == 0 {
+				continue
+			}
+
+			reason := restartReason(containerStatus)
+			group, exists := groups[reason]
+			if !exists {
+				group = &RestartReasonGroup{Reason: reason,
+					Containers: make([]ContainerRestarts, 0)}
+				groups[reason] = group
+			}
+
+			group.RestartCount += containerStatus.RestartCount
+			group.Containers = append(group.Containers, ContainerRestarts{
+				Pod:          pod.Name,
+				Namespace:    pod.Namespace,
+				Container:    containerStatus.Name,
+				RestartCount: containerStatus.RestartCount,
+			})
+		}
+	}
+
+	analysis := &RestartAnalysis{Reasons: make([]RestartReasonGroup, 0),
+		Errors: nonCriticalErrors}
+	for _, group := range groups {
+		sort.Slice(group.Containers, func(i, j int) bool {
+			return group.Containers[i].RestartCount > group.Containers[j].RestartCount
+		})
+		analysis.Reasons = append(analysis.Reasons, *group)
+	}
+	sort.Slice(analysis.Reasons, func(i, j int) bool {
+		return analysis.Reasons[i].RestartCount > analysis.Reasons[j].RestartCount
+	})
+
+	return analysis, nil
+}
+
+func restartReason(containerStatus v1.ContainerStatus) string {
+	terminated := containerStatus.LastTerminationState.Terminated
+	if terminated == nil || len(terminated.Reason) == 0 {
+		return unknownRestartReason
+	}
+	return terminated.Reason
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func restartTestPod(name string, statuses ...v1.ContainerStatus) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "test-ns"},
+		Status:     v1.PodStatus{ContainerStatuses: statuses},
+	}
+}
+
+func restartTestStatus(container string, restartCount int32, reason string) v1.ContainerStatus {
+	status := v1.ContainerStatus{Name: container, RestartCount: restartCount}
+	if len(reason) > 0 {
+		status.LastTerminationState = v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{Reason: reason},
+		}
+	}
+	return status
+}
+
+func TestGetRestartAnalysis(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		restartTestPod("oom-pod",
+			restartTestStatus("app", 5, "OOMKilled"),
+			restartTestStatus("healthy", 0, "")),
+		restartTestPod("crash-pod",
+			restartTestStatus("app", 2, "Error")),
+		restartTestPod("oom-pod-2",
+			restartTestStatus("worker", 1, "OOMKilled")),
+	)
+
+	analysis, err := GetRestartAnalysis(fakeClient, common.NewSameNamespaceQuery("test-ns"))
+	if err != nil {
+		t.Fatalf("GetRestartAnalysis(client, nsQuery) returned error: %s", err)
+	}
+
+	expected := []RestartReasonGroup{
+		{
+			Reason:       "OOMKilled",
+			RestartCount: 6,
+			Containers: []ContainerRestarts{
+				{Pod: "oom-pod", Namespace: "test-ns", Container: "app", RestartCount: 5},
+				{Pod: "oom-pod-2", Namespace: "test-ns", Container: "worker", RestartCount: 1},
+			},
+		},
+		{
+			Reason:       "Error",
+			RestartCount: 2,
+			Containers: []ContainerRestarts{
+				{Pod: "crash-pod", Namespace: "test-ns", Container: "app", RestartCount: 2},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(analysis.Reasons, expected) {
+		t.Errorf("GetRestartAnalysis(client, nsQuery).Reasons == \ngot %#v, \nexpected %#v",
+			analysis.Reasons, expected)
+	}
+}
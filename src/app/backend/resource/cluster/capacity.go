@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/node"
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClusterCapacity is a cluster wide comparison of node allocatable resources against the
+// resources requested by pods.
+type ClusterCapacity struct {
+	// Sum of allocatable CPU of all nodes, in millicores.
+	CPUAllocatable int64 `json:"cpuAllocatable"`
+
+	// Sum of CPU requests of all non-completed pods, in millicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// CPU still available for new requests, in millicores. Negative when overcommitted.
+	CPUHeadroom int64 `json:"cpuHeadroom"`
+
+	// Sum of allocatable memory of all nodes, in bytes.
+	MemoryAllocatable int64 `json:"memoryAllocatable"`
+
+	// Sum of memory requests of all non-completed pods, in bytes.
+	MemoryRequests int64 `json:"memoryRequests"`
+
+	// Memory still available for new requests, in bytes. Negative when overcommitted.
+	MemoryHeadroom int64 `json:"memoryHeadroom"`
+
+	// Per node breakdown of allocatable resources and requests.
+	Nodes []NodeCapacity `json:"nodes"`
+}
+
+// NodeCapacity compares the allocatable resources of a single node against the requests of
+// the pods scheduled onto it.
+type NodeCapacity struct {
+	// Name of the node.
+	Name string `json:"name"`
+
+	// Allocatable CPU of the node, in millicores.
+	CPUAllocatable int64 `json:"cpuAllocatable"`
+
+	// CPU requests of non-completed pods scheduled onto the node, in millicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// Allocatable memory of the node, in bytes.
+	MemoryAllocatable int64 `json:"memoryAllocatable"`
+
+	// Memory requests of non-completed pods scheduled onto the node, in bytes.
+	MemoryRequests int64 `json:"memoryRequests"`
+}
+
+// GetClusterCapacity returns total node allocatable CPU and memory compared against the
+// requests of all pods in the cluster. Pods that have run to completion no longer occupy
+// their requests and are excluded from the sums.
+func GetClusterCapacity(client kubernetes.Interface) (*ClusterCapacity, error) {
+	log.Print("Getting cluster capacity")
+
+	channels := &common.ResourceChannels{
+		NodeList: common.GetNodeListChannel(client, 1),
+		PodList:  common.GetPodListChannel(client, common.NewNamespaceQuery([]string{}), 1),
+	}
+
+	nodeList := <-channels.NodeList.List
+	if err := <-channels.NodeList.Error; err != nil {
+		return nil, err
+	}
+
+	podList := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	capacity := &ClusterCapacity{Nodes: make([]NodeCapacity, 0)}
+	nodeIndex := map[string]int{}
+	for _, n := range nodeList.Items {
+		nodeCapacity := NodeCapacity{
+			Name:              n.Name,
+			CPUAllocatable:    n.Status.Allocatable.Cpu().MilliValue(),
+			MemoryAllocatable: n.Status.Allocatable.Memory().Value(),
+		}
+		capacity.CPUAllocatable += nodeCapacity.CPUAllocatable
+		capacity.MemoryAllocatable += nodeCapacity.MemoryAllocatable
+		nodeIndex[n.Name] = len(capacity.Nodes)
+		capacity.Nodes = append(capacity.Nodes, nodeCapacity)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		reqs, _, err := node.PodRequestsAndLimits(&pod)
+		if err != nil {
+			return nil, err
+		}
+		cpuRequests, memoryRequests := reqs[v1.ResourceCPU], reqs[v1.ResourceMemory]
+
+		capacity.CPURequests += cpuRequests.MilliValue()
+		capacity.MemoryRequests += memoryRequests.Value()
+
+		if i, found := nodeIndex[pod.Spec.NodeName]; found {
+			capacity.Nodes[i].CPURequests += cpuRequests.MilliValue()
+			capacity.Nodes[i].MemoryRequests += memoryRequests.Value()
+		}
+	}
+
+	capacity.CPUHeadroom = capacity.CPUAllocatable - capacity.CPURequests
+	capacity.MemoryHeadroom = capacity.MemoryAllocatable - capacity.MemoryRequests
+	return capacity, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func capacityTestNode(name, cpu, memory string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func capacityTestPod(name, nodeName, cpu, memory string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{
+				{
+					Name:  "test-container",
+					Image: "test-image",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse(cpu),
+							v1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestGetClusterCapacity(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		capacityTestNode("node-1", "2", "4Gi"),
+		capacityTestNode("node-2", "1", "2Gi"),
+		capacityTestPod("pod-1", "node-1", "500m", "1Gi", v1.PodRunning),
+		capacityTestPod("pod-2", "node-2", "250m", "512Mi", v1.PodRunning),
+		// Completed pods no longer occupy their requests.
+		capacityTestPod("pod-3", "node-1", "1", "2Gi", v1.PodSucceeded),
+	)
+
+	actual, err := GetClusterCapacity(fakeClient)
+	if err != nil {
+		t.Fatalf("GetClusterCapacity(client) returned error: %s", err)
+	}
+
+	gi := int64(1024 * 1024 * 1024)
+	expected := &ClusterCapacity{
+		CPUAllocatable:    3000,
+		CPURequests:       750,
+		CPUHeadroom:       2250,
+		MemoryAllocatable: 6 * gi,
+		MemoryRequests:    gi + gi/2,
+		MemoryHeadroom:    4*gi + gi/2,
+		Nodes: []NodeCapacity{
+			{
+				Name:              "node-1",
+				CPUAllocatable:    2000,
+				CPURequests:       500,
+				MemoryAllocatable: 4 * gi,
+				MemoryRequests:    gi,
+			},
+			{
+				Name:              "node-2",
+				CPUAllocatable:    1000,
+				CPURequests:       250,
+				MemoryAllocatable: 2 * gi,
+				MemoryRequests:    gi / 2,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetClusterCapacity(client) == \ngot %#v, \nexpected %#v", actual, expected)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import "sort"
+
+// KeyDiff is a key-level difference between the stored and a proposed version of a map-backed
+// resource (config map, secret). It deliberately contains keys only, never values, so it is
+// safe to show for secrets.
+type KeyDiff struct {
+	// Keys present in the proposed version only.
+	AddedKeys []string `json:"addedKeys"`
+
+	// Keys present in both versions with different values.
+	ChangedKeys []string `json:"changedKeys"`
+
+	// Keys present in the stored version only.
+	RemovedKeys []string `json:"removedKeys"`
+}
+
+// GetKeyDiff compares given key lists and returns the diff. Keys present in both lists are
+// reported as changed when equal returns false for them. Returned key lists are sorted.
+func GetKeyDiff(storedKeys, proposedKeys []string, equal func(key string) bool) *KeyDiff {
+	stored := make(map[string]bool, len(storedKeys))
+	for _, key := range storedKeys {
+		stored[key] = true
+	}
+	proposed := make(map[string]bool, len(proposedKeys))
+	for _, key := range proposedKeys {
+		proposed[key] = true
+	}
+
+	diff := &KeyDiff{
+		AddedKeys:   make([]string, 0),
+		ChangedKeys: make([]string, 0),
+		RemovedKeys: make([]string, 0),
+	}
+
+	for key := range proposed {
+		if !stored[key] {
+			diff.AddedKeys = append(diff.AddedKeys, key)
+		} else if !equal(key) {
+			diff.ChangedKeys = append(diff.ChangedKeys, key)
+		}
+	}
+	for key := range stored {
+		if !proposed[key] {
+			diff.RemovedKeys = append(diff.RemovedKeys, key)
+		}
+	}
+
+	sort.Strings(diff.AddedKeys)
+	sort.Strings(diff.ChangedKeys)
+	sort.Strings(diff.RemovedKeys)
+	return diff
+}
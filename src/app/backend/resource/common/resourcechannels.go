@@ -383,6 +383,14 @@ type ReplicationControllerListChannel struct {
 // numReads times.
 func GetReplicationControllerListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) ReplicationControllerListChannel {
+	return GetReplicationControllerListChannelWithOptions(client, nsQuery, api.ListEverything,
+		numReads)
+}
+
+// GetReplicationControllerListChannelWithOptions is GetReplicationControllerListChannel plus
+// list options.
+func GetReplicationControllerListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) ReplicationControllerListChannel {
 
 	channel := ReplicationControllerListChannel{
 		List:  make(chan *v1.ReplicationControllerList, numReads),
@@ -391,7 +399,7 @@ func GetReplicationControllerListChannel(client client.Interface,
 
 	go func() {
 		list, err := client.CoreV1().ReplicationControllers(nsQuery.ToRequestParam()).
-			List(api.ListEverything)
+			List(options)
 		var filteredItems []v1.ReplicationController
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -418,6 +426,12 @@ type DeploymentListChannel struct {
 // that both must be read numReads times.
 func GetDeploymentListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) DeploymentListChannel {
+	return GetDeploymentListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetDeploymentListChannelWithOptions is GetDeploymentListChannel plus list options.
+func GetDeploymentListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) DeploymentListChannel {
 
 	channel := DeploymentListChannel{
 		List:  make(chan *apps.DeploymentList, numReads),
@@ -426,7 +440,7 @@ func GetDeploymentListChannel(client client.Interface,
 
 	go func() {
 		list, err := client.AppsV1beta2().Deployments(nsQuery.ToRequestParam()).
-			List(api.ListEverything)
+			List(options)
 		var filteredItems []apps.Deployment
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -493,13 +507,19 @@ type DaemonSetListChannel struct {
 // GetDaemonSetListChannel returns a pair of channels to a DaemonSet list and errors that both must be read
 // numReads times.
 func GetDaemonSetListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) DaemonSetListChannel {
+	return GetDaemonSetListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetDaemonSetListChannelWithOptions is GetDaemonSetListChannel plus list options.
+func GetDaemonSetListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) DaemonSetListChannel {
 	channel := DaemonSetListChannel{
 		List:  make(chan *apps.DaemonSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1beta2().DaemonSets(nsQuery.ToRequestParam()).List(api.ListEverything)
+		list, err := client.AppsV1beta2().DaemonSets(nsQuery.ToRequestParam()).List(options)
 		var filteredItems []apps.DaemonSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -525,13 +545,19 @@ type JobListChannel struct {
 // GetJobListChannel returns a pair of channels to a Job list and errors that both must be read numReads times.
 func GetJobListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) JobListChannel {
+	return GetJobListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetJobListChannelWithOptions is GetJobListChannel plus list options.
+func GetJobListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) JobListChannel {
 	channel := JobListChannel{
 		List:  make(chan *batch.JobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(api.ListEverything)
+		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(options)
 		var filteredItems []batch.Job
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -556,13 +582,19 @@ type CronJobListChannel struct {
 
 // GetCronJobListChannel returns a pair of channels to a Cron Job list and errors that both must be read numReads times.
 func GetCronJobListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) CronJobListChannel {
+	return GetCronJobListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetCronJobListChannelWithOptions is GetCronJobListChannel plus list options.
+func GetCronJobListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) CronJobListChannel {
 	channel := CronJobListChannel{
 		List:  make(chan *batch2.CronJobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(api.ListEverything)
+		list, err := client.BatchV1beta1().CronJobs(nsQuery.ToRequestParam()).List(options)
 		var filteredItems []batch2.CronJob
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -589,13 +621,19 @@ type StatefulSetListChannel struct {
 // numReads times.
 func GetStatefulSetListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) StatefulSetListChannel {
+	return GetStatefulSetListChannelWithOptions(client, nsQuery, api.ListEverything, numReads)
+}
+
+// GetStatefulSetListChannelWithOptions is GetStatefulSetListChannel plus list options.
+func GetStatefulSetListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) StatefulSetListChannel {
 	channel := StatefulSetListChannel{
 		List:  make(chan *apps.StatefulSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		statefulSets, err := client.AppsV1beta2().StatefulSets(nsQuery.ToRequestParam()).List(api.ListEverything)
+		statefulSets, err := client.AppsV1beta2().StatefulSets(nsQuery.ToRequestParam()).List(options)
 		var filteredItems []apps.StatefulSet
 		for _, item := range statefulSets.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
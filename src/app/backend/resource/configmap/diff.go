@@ -0,0 +1,49 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configmap
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetConfigMapDiff compares the stored config map with a proposed version of it and returns a
+// key-level diff of its data. Nothing is persisted - this is used to preview an update before
+// applying it.
+func GetConfigMapDiff(client kubernetes.Interface, namespace, name string,
+	proposed *v1.ConfigMap) (*common.KeyDiff, error) {
+	log.Printf("Getting diff of %s config map in %s namespace", name, namespace)
+
+	stored, err := client.CoreV1().ConfigMaps(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return common.GetKeyDiff(mapKeys(stored.Data), mapKeys(proposed.Data), func(key string) bool {
+		return stored.Data[key] == proposed.Data[key]
+	}), nil
+}
+
+func mapKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configmap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetConfigMapDiff(t *testing.T) {
+	stored := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "cm-1", Namespace: "ns-1"},
+		Data: map[string]string{
+			"unchanged.properties": "key=value",
+			"log.level":            "info",
+			"obsolete.properties":  "old=true",
+		},
+	}
+	proposed := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "cm-1", Namespace: "ns-1"},
+		Data: map[string]string{
+			"unchanged.properties": "key=value",
+			"log.level":            "debug",
+			"new.properties":       "new=true",
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(stored)
+
+	actual, err := GetConfigMapDiff(fakeClient, "ns-1", "cm-1", proposed)
+	if err != nil {
+		t.Fatalf("GetConfigMapDiff(client, ns-1, cm-1, proposed) returned error: %s", err)
+	}
+
+	expected := &common.KeyDiff{
+		AddedKeys:   []string{"new.properties"},
+		ChangedKeys: []string{"log.level"},
+		RemovedKeys: []string{"obsolete.properties"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetConfigMapDiff(client, ns-1, cm-1, proposed) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configmap
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ConfigMapUsage lists the objects in a namespace that reference a config map, so that users
+// can check whether it is still in use before deleting it.
+type ConfigMapUsage struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Names of pods in the namespace that reference the config map through envFrom, valueFrom
+	// or a volume.
+	Pods []string `json:"pods"`
+
+	// Names of deployments in the namespace whose pod template references the config map.
+	Deployments []string `json:"deployments"`
+
+	// True when at least one object references the config map.
+	InUse bool `json:"inUse"`
+}
+
+// GetConfigMapUsage returns the pods and deployments in the given namespace that reference the
+// given config map.
+func GetConfigMapUsage(client kubernetes.Interface, namespace, name string) (*ConfigMapUsage, error) {
+	log.Printf("Getting usage of %s config map in %s namespace", name, namespace)
+
+	configMap, err := client.CoreV1().ConfigMaps(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nsQuery := common.NewSameNamespaceQuery(namespace)
+	channels := &common.ResourceChannels{
+		PodList:        common.GetPodListChannel(client, nsQuery, 1),
+		DeploymentList: common.GetDeploymentListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+	deployments := <-channels.DeploymentList.List
+	if err := <-channels.DeploymentList.Error; err != nil {
+		return nil, err
+	}
+
+	usage := &ConfigMapUsage{
+		ObjectMeta:  api.NewObjectMeta(configMap.ObjectMeta),
+		TypeMeta:    api.NewTypeMeta(api.ResourceKindConfigMap),
+		Pods:        make([]string, 0),
+		Deployments: make([]string, 0),
+	}
+	for _, pod := range pods.Items {
+		if podSpecReferencesConfigMap(&pod.Spec, name) {
+			usage.Pods = append(usage.Pods, pod.Name)
+		}
+	}
+	for _, deployment := range deployments.Items {
+		if podSpecReferencesConfigMap(&deployment.Spec.Template.Spec, name) {
+			usage.Deployments = append(usage.Deployments, deployment.Name)
+		}
+	}
+	sort.Strings(usage.Pods)
+	sort.Strings(usage.Deployments)
+	usage.InUse = len(usage.Pods) > 0 || len(usage.Deployments) > 0
+
+	return usage, nil
+}
+
+// podSpecReferencesConfigMap checks whether the pod spec references the config map through a
+// volume, envFrom or valueFrom of any of its containers.
+func podSpecReferencesConfigMap(spec *v1.PodSpec, name string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil && volume.ConfigMap.Name == name {
+			return true
+		}
+	}
+	for _, container := range spec.InitContainers {
+		if containerReferencesConfigMap(container, name) {
+			return true
+		}
+	}
+	for _, container := range spec.Containers {
+		if containerReferencesConfigMap(container, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containerReferencesConfigMap(container v1.Container, name string) bool {
+	for _, envFrom := range container.EnvFrom {
+		if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+			return true
+		}
+	}
+	for _, env := range container.Env {
+		if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil &&
+			env.ValueFrom.ConfigMapKeyRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configmap
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetConfigMapUsage(t *testing.T) {
+	namespace := "ns-1"
+	configMap := &v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{
+		Name: "used-config", Namespace: namespace,
+	}}
+	podWithVolume := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-with-volume", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{{
+				Name: "config",
+				VolumeSource: v1.VolumeSource{
+					ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: v1.LocalObjectReference{Name: "used-config"},
+					},
+				},
+			}},
+		},
+	}
+	podWithEnvFrom := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-with-env-from", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "container-1",
+				EnvFrom: []v1.EnvFromSource{{
+					ConfigMapRef: &v1.ConfigMapEnvSource{
+						LocalObjectReference: v1.LocalObjectReference{Name: "used-config"},
+					},
+				}},
+			}},
+		},
+	}
+	unrelatedPod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unrelated-pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(configMap, podWithVolume, podWithEnvFrom, unrelatedPod)
+
+	usage, err := GetConfigMapUsage(fakeClient, namespace, "used-config")
+	if err != nil {
+		t.Fatalf("GetConfigMapUsage(client, %s, used-config) returned error: %s", namespace, err)
+	}
+
+	expectedPods := []string{"pod-with-env-from", "pod-with-volume"}
+	if !reflect.DeepEqual(usage.Pods, expectedPods) {
+		t.Errorf("Expected pods using config map to be %#v but got %#v", expectedPods, usage.Pods)
+	}
+	if !usage.InUse {
+		t.Error("Expected config map to be reported as in use")
+	}
+}
+
+func TestGetConfigMapUsageUnreferenced(t *testing.T) {
+	namespace := "ns-1"
+	configMap := &v1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{
+		Name: "unused-config", Namespace: namespace,
+	}}
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(configMap, pod)
+
+	usage, err := GetConfigMapUsage(fakeClient, namespace, "unused-config")
+	if err != nil {
+		t.Fatalf("GetConfigMapUsage(client, %s, unused-config) returned error: %s", namespace, err)
+	}
+
+	if len(usage.Pods) != 0 || len(usage.Deployments) != 0 {
+		t.Errorf("Expected no objects using config map but got pods %#v and deployments %#v",
+			usage.Pods, usage.Deployments)
+	}
+	if usage.InUse {
+		t.Error("Expected config map to be reported as not in use")
+	}
+}
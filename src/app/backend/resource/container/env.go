@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"fmt"
+	"log"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
+)
+
+// redactedValue is what is returned in place of values that come from secrets.
+const redactedValue = "<redacted>"
+
+// ContainerEnv is a list of resolved environment variables of a single container.
+type ContainerEnv struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// List of environment variables with valueFrom references resolved where readable.
+	Env []ContainerEnvVar `json:"env"`
+}
+
+// ContainerEnvVar is a single environment variable of a container. If the variable is derived
+// from a reference, ValueFrom holds the original source and Value holds the effective value.
+type ContainerEnvVar struct {
+	// Name of the variable.
+	Name string `json:"name"`
+
+	// Effective value of the variable. Secret-sourced values are redacted.
+	Value string `json:"value"`
+
+	// Original source of a derived variable, nil for plain values.
+	ValueFrom *v1.EnvVarSource `json:"valueFrom"`
+}
+
+// GetContainerEnv returns environment variables of a named container of a pod with valueFrom
+// references (configMapKeyRef/secretKeyRef/fieldRef) resolved to their effective values where
+// readable. Values that come from secrets are redacted.
+func GetContainerEnv(client kubernetes.Interface, namespace, podID, containerName string) (*ContainerEnv, error) {
+	log.Printf("Getting environment of %s container of %s pod in %s namespace", containerName,
+		podID, namespace)
+
+	podObj, err := client.CoreV1().Pods(namespace).Get(podID, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	container := findContainer(podObj, containerName)
+	if container == nil {
+		return nil, kdErrors.NewNotFound(fmt.Sprintf("Container %s is not found in pod %s",
+			containerName, podID))
+	}
+
+	result := &ContainerEnv{Name: container.Name, Env: make([]ContainerEnvVar, 0)}
+	for _, envVar := range container.Env {
+		variable := ContainerEnvVar{
+			Name:      envVar.Name,
+			Value:     envVar.Value,
+			ValueFrom: envVar.ValueFrom,
+		}
+		if envVar.ValueFrom != nil {
+			variable.Value = resolveEnvValueFrom(client, namespace, podObj, envVar.ValueFrom)
+		}
+		result.Env = append(result.Env, variable)
+	}
+
+	return result, nil
+}
+
+// findContainer returns the named container or init container of a pod, nil if there is none.
+func findContainer(pod *v1.Pod, name string) *v1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	for i := range pod.Spec.InitContainers {
+		if pod.Spec.InitContainers[i].Name == name {
+			return &pod.Spec.InitContainers[i]
+		}
+	}
+	return nil
+}
+
+// resolveEnvValueFrom resolves a single valueFrom reference. Unreadable references resolve to an
+// empty string so that the variable is still listed.
+func resolveEnvValueFrom(client kubernetes.Interface, namespace string, podObj *v1.Pod,
+	src *v1.EnvVarSource) string {
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		configMap, err := client.CoreV1().ConfigMaps(namespace).Get(
+			src.ConfigMapKeyRef.LocalObjectReference.Name, metaV1.GetOptions{})
+		if err != nil {
+			log.Println(err)
+			return ""
+		}
+		return configMap.Data[src.ConfigMapKeyRef.Key]
+	case src.SecretKeyRef != nil:
+		return redactedValue
+	case src.FieldRef != nil:
+		internalFieldPath, _, err := runtime.NewScheme().ConvertFieldLabel(src.FieldRef.APIVersion,
+			"Pod", src.FieldRef.FieldPath, "")
+		if err != nil {
+			log.Println(err)
+			return ""
+		}
+		valueFrom, err := pod.ExtractFieldPathAsString(podObj, internalFieldPath)
+		if err != nil {
+			log.Println(err)
+			return ""
+		}
+		return valueFrom
+	}
+	return ""
+}
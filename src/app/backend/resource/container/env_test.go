@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetContainerEnv(t *testing.T) {
+	namespace := "test-namespace"
+	configMapEnvVar := v1.EnvVar{
+		Name: "CONFIG_VALUE",
+		ValueFrom: &v1.EnvVarSource{
+			ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "test-configmap"},
+				Key:                  "key",
+			},
+		},
+	}
+	secretEnvVar := v1.EnvVar{
+		Name: "SECRET_VALUE",
+		ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "test-secret"},
+				Key:                  "key",
+			},
+		},
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "test-container",
+				Env:  []v1.EnvVar{configMapEnvVar, secretEnvVar},
+			}},
+		},
+	}
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-configmap", Namespace: namespace},
+		Data:       map[string]string{"key": "config-value"},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-secret", Namespace: namespace},
+		Data:       map[string][]byte{"key": []byte("secret-value")},
+	}
+
+	expected := &ContainerEnv{
+		Name: "test-container",
+		Env: []ContainerEnvVar{
+			{Name: "CONFIG_VALUE", Value: "config-value", ValueFrom: configMapEnvVar.ValueFrom},
+			{Name: "SECRET_VALUE", Value: redactedValue, ValueFrom: secretEnvVar.ValueFrom},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(pod, configMap, secret)
+	actual, err := GetContainerEnv(fakeClient, namespace, "test-pod", "test-container")
+	if err != nil {
+		t.Errorf("GetContainerEnv(client, %s, test-pod, test-container) returned error: %s",
+			namespace, err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetContainerEnv(client, %s, test-pod, test-container) == %#v, expected %#v",
+			namespace, actual, expected)
+	}
+}
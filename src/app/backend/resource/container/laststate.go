@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"fmt"
+	"log"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
+)
+
+// ContainerLastState describes why a container last terminated, so the UI can show the
+// cause of the previous death without parsing full pod JSON.
+type ContainerLastState struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Terminated is true when the container has a recorded previous termination.
+	Terminated bool `json:"terminated"`
+
+	// Reason of the last termination, e.g. "OOMKilled", empty when not terminated before.
+	Reason string `json:"reason,omitempty"`
+
+	// Message of the last termination.
+	Message string `json:"message,omitempty"`
+
+	// ExitCode of the last termination.
+	ExitCode int32 `json:"exitCode,omitempty"`
+
+	// Signal the container was killed with, zero when it exited on its own.
+	Signal int32 `json:"signal,omitempty"`
+
+	// StartedAt is the time the last terminated run started.
+	StartedAt metaV1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is the time the last terminated run ended.
+	FinishedAt metaV1.Time `json:"finishedAt,omitempty"`
+}
+
+// GetContainerLastState returns the last termination details of a named container of a pod,
+// derived from the last termination state of its status.
+func GetContainerLastState(client kubernetes.Interface, namespace, podID,
+	containerName string) (*ContainerLastState, error) {
+	log.Printf("Getting last state of %s container of %s pod in %s namespace", containerName,
+		podID, namespace)
+
+	podObj, err := client.CoreV1().Pods(namespace).Get(podID, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	status := findContainerStatus(podObj, containerName)
+	if status == nil {
+		return nil, kdErrors.NewNotFound(fmt.Sprintf("Container %s is not found in pod %s",
+			containerName, podID))
+	}
+
+	return toContainerLastState(status), nil
+}
+
+// findContainerStatus returns the status of the named container or init container of a pod,
+// nil if there is none.
+func findContainerStatus(pod *v1.Pod, name string) *v1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == name {
+			return &pod.Status.ContainerStatuses[i]
+		}
+	}
+	for i := range pod.Status.InitContainerStatuses {
+		if pod.Status.InitContainerStatuses[i].Name == name {
+			return &pod.Status.InitContainerStatuses[i]
+		}
+	}
+	return nil
+}
+
+func toContainerLastState(status *v1.ContainerStatus) *ContainerLastState {
+	lastState := &ContainerLastState{Name: status.Name}
+	if terminated := status.LastTerminationState.Terminated; terminated != nil {
+		lastState.Terminated = true
+		lastState.Reason = terminated.Reason
+		lastState.Message = terminated.Message
+		lastState.ExitCode = terminated.ExitCode
+		lastState.Signal = terminated.Signal
+		lastState.StartedAt = terminated.StartedAt
+		lastState.FinishedAt = terminated.FinishedAt
+	}
+	return lastState
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetContainerLastState(t *testing.T) {
+	startedAt := metaV1.NewTime(time.Date(2017, 1, 1, 10, 0, 0, 0, time.UTC))
+	finishedAt := metaV1.NewTime(time.Date(2017, 1, 1, 10, 5, 0, 0, time.UTC))
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "oom-container",
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{
+							Reason:     "OOMKilled",
+							ExitCode:   137,
+							Signal:     9,
+							StartedAt:  startedAt,
+							FinishedAt: finishedAt,
+						},
+					},
+				},
+				{Name: "healthy-container"},
+			},
+		},
+	}
+	fakeClient := fake.NewSimpleClientset(pod)
+
+	cases := []struct {
+		containerName string
+		expected      *ContainerLastState
+	}{
+		{
+			"oom-container",
+			&ContainerLastState{
+				Name:       "oom-container",
+				Terminated: true,
+				Reason:     "OOMKilled",
+				ExitCode:   137,
+				Signal:     9,
+				StartedAt:  startedAt,
+				FinishedAt: finishedAt,
+			},
+		},
+		{
+			"healthy-container",
+			&ContainerLastState{Name: "healthy-container"},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := GetContainerLastState(fakeClient, "ns-1", "pod-1", c.containerName)
+		if err != nil {
+			t.Fatalf("GetContainerLastState(client, ns-1, pod-1, %s) returned error: %s",
+				c.containerName, err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetContainerLastState(client, ns-1, pod-1, %s) == \ngot %#v, \nexpected %#v",
+				c.containerName, actual, c.expected)
+		}
+	}
+
+	if _, err := GetContainerLastState(fakeClient, "ns-1", "pod-1", "missing"); err == nil {
+		t.Errorf("GetContainerLastState(client, ns-1, pod-1, missing) did not return error")
+	}
+}
@@ -15,8 +15,10 @@
 package container
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
 
 	"github.com/kubernetes/dashboard/src/app/backend/resource/logs"
 	"k8s.io/api/core/v1"
@@ -53,9 +55,11 @@ func GetPodContainers(client kubernetes.Interface, namespace, podID string) (*Po
 }
 
 // GetLogDetails returns logs for particular pod and container. When container is null, logs for the first one
-// are returned. Previous indicates to read archived logs created by log rotation or container crash
+// are returned. Previous indicates to read archived logs created by log rotation or container crash.
+// When filter is not empty, only log lines containing it (ignoring case) are returned, with the
+// filter applied before the log view selection.
 func GetLogDetails(client kubernetes.Interface, namespace, podID string, container string,
-	logSelector *logs.Selection, usePreviousLogs bool) (*logs.LogDetails, error) {
+	logSelector *logs.Selection, usePreviousLogs bool, filter string, classify bool) (*logs.LogDetails, error) {
 	pod, err := client.CoreV1().Pods(namespace).Get(podID, metaV1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -70,10 +74,72 @@ func GetLogDetails(client kubernetes.Interface, namespace, podID string, contain
 	if err != nil {
 		return nil, err
 	}
-	details := ConstructLogDetails(podID, rawLogs, container, logSelector)
+	details := ConstructLogDetails(podID, rawLogs, container, logSelector, filter)
+	if classify {
+		details.LogLines = details.LogLines.Classify()
+	}
 	return details, nil
 }
 
+// GetAllContainersLogDetails returns logs of all containers of the pod merged into a single
+// view. Lines are interleaved by timestamp and prefixed with the name of the container they
+// came from, which helps debugging interactions with sidecars. The log view selection is
+// applied to the merged lines, so the existing windowing caps the total number of lines.
+func GetAllContainersLogDetails(client kubernetes.Interface, namespace, podID string,
+	logSelector *logs.Selection, usePreviousLogs bool) (*logs.LogDetails, error) {
+	pod, err := client.CoreV1().Pods(namespace).Get(podID, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	merged := logs.LogLines{}
+	totalRawLength := 0
+	for _, container := range pod.Spec.Containers {
+		logOptions := mapToLogOptions(container.Name, logSelector, usePreviousLogs)
+		rawLogs, err := readRawLogs(client, namespace, podID, logOptions)
+		if err != nil {
+			return nil, err
+		}
+		totalRawLength += len(rawLogs)
+		merged = append(merged, prefixLogLines(rawLogs, container.Name)...)
+	}
+	sortLogLines(merged)
+
+	logLines, fromDate, toDate, logSelection, lastPage := merged.SelectLogs(logSelector)
+	readLimitReached := isReadLimitReached(int64(totalRawLength), int64(len(merged)),
+		logSelector.LogFilePosition)
+
+	return &logs.LogDetails{
+		Info: logs.LogInfo{
+			PodName:   podID,
+			FromDate:  fromDate,
+			ToDate:    toDate,
+			Truncated: readLimitReached && lastPage,
+		},
+		Selection: logSelection,
+		LogLines:  logLines,
+	}, nil
+}
+
+// prefixLogLines converts raw logs of a container into log lines with the container name
+// prepended to the content, so that merged views show where every line came from.
+func prefixLogLines(rawLogs string, container string) logs.LogLines {
+	logLines := logs.ToLogLines(rawLogs)
+	for idx := range logLines {
+		logLines[idx].Content = fmt.Sprintf("[%s] %s", container, logLines[idx].Content)
+	}
+	return logLines
+}
+
+// sortLogLines sorts log lines chronologically. Timestamps are RFC3339, so their lexicographic
+// order is their chronological order. The stable sort keeps the original order of lines that
+// share a timestamp.
+func sortLogLines(logLines logs.LogLines) {
+	sort.SliceStable(logLines, func(i, j int) bool {
+		return logLines[i].Timestamp < logLines[j].Timestamp
+	})
+}
+
 // Maps the log selection to the corresponding api object
 // Read limits are set to avoid out of memory issues
 func mapToLogOptions(container string, logSelector *logs.Selection, previous bool) *v1.PodLogOptions {
@@ -133,10 +199,12 @@ func openStream(client kubernetes.Interface, namespace, podID string, logOptions
 		VersionedParams(logOptions, scheme.ParameterCodec).Stream()
 }
 
-// ConstructLogDetails creates a new log details structure for given parameters.
-func ConstructLogDetails(podID string, rawLogs string, container string, logSelector *logs.Selection) *logs.LogDetails {
+// ConstructLogDetails creates a new log details structure for given parameters. A non-empty
+// filter restricts the log view to the lines containing it, ignoring case.
+func ConstructLogDetails(podID string, rawLogs string, container string, logSelector *logs.Selection,
+	filter string) *logs.LogDetails {
 	parsedLines := logs.ToLogLines(rawLogs)
-	logLines, fromDate, toDate, logSelection, lastPage := parsedLines.SelectLogs(logSelector)
+	logLines, fromDate, toDate, logSelection, lastPage := parsedLines.Filter(filter).SelectLogs(logSelector)
 
 	readLimitReached := isReadLimitReached(int64(len(rawLogs)), int64(len(parsedLines)), logSelector.LogFilePosition)
 	truncated := readLimitReached && lastPage
@@ -343,7 +343,7 @@ func TestGetLogs(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		actual := ConstructLogDetails(c.podId, c.rawLogs, c.container, c.logSelector)
+		actual := ConstructLogDetails(c.podId, c.rawLogs, c.container, c.logSelector, "")
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("Test Case: %s.\nReceived: %#v \nExpected: %#v\n\n", c.info, actual, c.expected)
 		}
@@ -351,6 +351,63 @@ func TestGetLogs(t *testing.T) {
 	}
 }
 
+func TestInterleaveContainerLogLines(t *testing.T) {
+	appLogs := "2017-01-01T10:00:00Z app started\n2017-01-01T10:00:02Z request handled"
+	sidecarLogs := "2017-01-01T10:00:01Z proxy ready"
+
+	merged := prefixLogLines(appLogs, "app")
+	merged = append(merged, prefixLogLines(sidecarLogs, "sidecar")...)
+	sortLogLines(merged)
+
+	expected := logs.LogLines{logs.LogLine{
+		Timestamp: "2017-01-01T10:00:00Z",
+		Content:   "[app] app started",
+	}, logs.LogLine{
+		Timestamp: "2017-01-01T10:00:01Z",
+		Content:   "[sidecar] proxy ready",
+	}, logs.LogLine{
+		Timestamp: "2017-01-01T10:00:02Z",
+		Content:   "[app] request handled",
+	}}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("Interleaved container logs.\nReceived: %#v \nExpected: %#v\n\n", merged,
+			expected)
+	}
+}
+
+func TestConstructLogDetailsWithFilter(t *testing.T) {
+	rawLogs := "1 log1 error\n2 log2\n3 LOG3 ERROR\n4 log4"
+	expected := &logs.LogDetails{
+		Info: logs.LogInfo{
+			PodName:       "pod-1",
+			ContainerName: "test",
+			FromDate:      "1",
+			ToDate:        "3",
+		},
+		LogLines: logs.LogLines{logs.LogLine{
+			Timestamp: "1",
+			Content:   "log1 error",
+		}, logs.LogLine{
+			Timestamp: "3",
+			Content:   "LOG3 ERROR",
+		}},
+		Selection: logs.Selection{
+			ReferencePoint: logs.LogLineId{
+				LogTimestamp: "3",
+				LineNum:      1,
+			},
+			OffsetFrom: -1,
+			OffsetTo:   1,
+		},
+	}
+
+	actual := ConstructLogDetails("pod-1", rawLogs, "test", logs.AllSelection, "error")
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ConstructLogDetails with filter.\nReceived: %#v \nExpected: %#v\n\n", actual,
+			expected)
+	}
+}
+
 func TestMapToLogOptions(t *testing.T) {
 	cases := []struct {
 		info        string
@@ -389,3 +446,25 @@ func TestMapToLogOptions(t *testing.T) {
 
 	}
 }
+
+func TestClassifyLogLines(t *testing.T) {
+	lines := logs.ToLogLines("1 ERROR: request failed\n2 WARN: retrying\n3 request done")
+	expected := logs.LogLines{logs.LogLine{
+		Timestamp: "1",
+		Content:   "ERROR: request failed",
+		Severity:  logs.SeverityError,
+	}, logs.LogLine{
+		Timestamp: "2",
+		Content:   "WARN: retrying",
+		Severity:  logs.SeverityWarn,
+	}, logs.LogLine{
+		Timestamp: "3",
+		Content:   "request done",
+		Severity:  logs.SeverityInfo,
+	}}
+
+	actual := lines.Classify()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Classify.\nReceived: %#v \nExpected: %#v\n\n", actual, expected)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// DaemonSetDeletion describes the outcome of deleting a daemon set.
+type DaemonSetDeletion struct {
+	// Number of pods that were left running because cascade deletion was switched off.
+	OrphanedPods int `json:"orphanedPods"`
+}
+
+// DeleteDaemonSet deletes the daemon set with the given name in the given namespace. When
+// orphanPods is true dependent pods are left running and their number is reported in the
+// result, otherwise they are deleted together with the daemon set. When deleteServices is true
+// services matching the daemon set selector are deleted as well.
+func DeleteDaemonSet(client k8sClient.Interface, namespace, name string, deleteServices,
+	orphanPods bool) (*DaemonSetDeletion, error) {
+
+	log.Printf("Deleting %s daemon set in %s namespace", name, namespace)
+
+	pods, err := getRawDaemonSetPods(client, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleteServices {
+		if err := deleteDaemonSetServices(client, namespace, name); err != nil {
+			return nil, err
+		}
+	}
+
+	propagationPolicy := metaV1.DeletePropagationForeground
+	if orphanPods {
+		propagationPolicy = metaV1.DeletePropagationOrphan
+	}
+
+	err = client.AppsV1beta2().DaemonSets(namespace).Delete(name,
+		&metaV1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := &DaemonSetDeletion{}
+	if orphanPods {
+		deletion.OrphanedPods = len(pods)
+	}
+	return deletion, nil
+}
+
+// deleteDaemonSetServices deletes services matching the selector of the daemon set with the
+// given name.
+func deleteDaemonSetServices(client k8sClient.Interface, namespace, name string) error {
+	daemonSet, err := client.AppsV1beta2().DaemonSets(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	services, err := client.CoreV1().Services(namespace).List(metaV1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	matchingServices := common.FilterNamespacedServicesBySelector(services.Items, namespace,
+		daemonSet.Spec.Selector.MatchLabels)
+	for _, service := range matchingServices {
+		err := client.CoreV1().Services(namespace).Delete(service.Name, &metaV1.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDeleteDaemonSet(t *testing.T) {
+	controller := true
+	daemonSet := &apps.DaemonSet{
+		ObjectMeta: metaV1.ObjectMeta{Name: "ds-1", Namespace: "ns-1", UID: "uid-1"},
+		Spec: apps.DaemonSetSpec{
+			Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "ds-1"}},
+		},
+	}
+	ownerRef := []metaV1.OwnerReference{{
+		Kind:       "DaemonSet",
+		Name:       "ds-1",
+		UID:        "uid-1",
+		Controller: &controller,
+	}}
+
+	cases := []struct {
+		deleteServices, orphanPods bool
+		expectedOrphanedPods       int
+	}{
+		{false, false, 0},
+		{true, true, 2},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset(daemonSet,
+			&api.Pod{ObjectMeta: metaV1.ObjectMeta{
+				Name: "pod-1", Namespace: "ns-1", OwnerReferences: ownerRef}},
+			&api.Pod{ObjectMeta: metaV1.ObjectMeta{
+				Name: "pod-2", Namespace: "ns-1", OwnerReferences: ownerRef}},
+			&api.Service{
+				ObjectMeta: metaV1.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+				Spec:       api.ServiceSpec{Selector: map[string]string{"app": "ds-1"}},
+			},
+			&api.Service{
+				ObjectMeta: metaV1.ObjectMeta{Name: "svc-2", Namespace: "ns-1"},
+				Spec:       api.ServiceSpec{Selector: map[string]string{"app": "other"}},
+			})
+
+		deletion, err := DeleteDaemonSet(client, "ns-1", "ds-1", c.deleteServices, c.orphanPods)
+		if err != nil {
+			t.Fatalf("DeleteDaemonSet(deleteServices=%t, orphanPods=%t) returned error: %v",
+				c.deleteServices, c.orphanPods, err)
+		}
+		if deletion.OrphanedPods != c.expectedOrphanedPods {
+			t.Errorf("DeleteDaemonSet(deleteServices=%t, orphanPods=%t) reported %d orphaned pods, expected %d",
+				c.deleteServices, c.orphanPods, deletion.OrphanedPods, c.expectedOrphanedPods)
+		}
+
+		if _, err := client.AppsV1beta2().DaemonSets("ns-1").Get("ds-1", metaV1.GetOptions{}); err == nil {
+			t.Errorf("DeleteDaemonSet(deleteServices=%t, orphanPods=%t) did not delete the daemon set",
+				c.deleteServices, c.orphanPods)
+		}
+
+		_, err = client.CoreV1().Services("ns-1").Get("svc-1", metaV1.GetOptions{})
+		if c.deleteServices && err == nil {
+			t.Errorf("DeleteDaemonSet(deleteServices=true) did not delete the matching service")
+		}
+		if !c.deleteServices && err != nil {
+			t.Errorf("DeleteDaemonSet(deleteServices=false) deleted the matching service")
+		}
+
+		if _, err := client.CoreV1().Services("ns-1").Get("svc-2", metaV1.GetOptions{}); err != nil {
+			t.Errorf("DeleteDaemonSet(deleteServices=%t) deleted a service not matching the selector",
+				c.deleteServices)
+		}
+	}
+}
@@ -154,7 +154,8 @@ func (self *DataSelector) getMetrics(metricClient metricapi.MetricClient) (
 	}
 
 	for _, metricName := range metricNames {
-		promises := metricClient.DownloadMetric(selectors, metricName, self.CachedResources)
+		promises := stdMetricDownloader.Download(metricClient, selectors, metricName,
+			self.CachedResources)
 		metricPromises = append(metricPromises, promises)
 	}
 
@@ -0,0 +1,112 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"fmt"
+	"sync"
+
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// maxConcurrentMetricDownloads limits the number of metric downloads running at the same time,
+// so that data select pipelines built in loops do not flood the metric backend with requests.
+const maxConcurrentMetricDownloads = 5
+
+// stdMetricDownloader coordinates all metric downloads issued by data select pipelines.
+var stdMetricDownloader = newMetricDownloader(maxConcurrentMetricDownloads)
+
+// metricDownloader bounds the number of concurrent metric downloads and deduplicates identical
+// ones. Data select pipelines are often built per-item in loops, so without coordination the
+// same metrics would be downloaded multiple times by an unbounded number of goroutines.
+type metricDownloader struct {
+	// downloadSlots is a semaphore limiting the number of downloads running concurrently.
+	downloadSlots chan struct{}
+	// mutex guards inFlight.
+	mutex sync.Mutex
+	// inFlight maps download keys to downloads that have been started but have not finished
+	// yet. Downloads requested while an identical one is in flight share its result.
+	inFlight map[string]*inFlightDownload
+}
+
+// inFlightDownload holds the result of a single metric download shared by all its requesters.
+type inFlightDownload struct {
+	// done is closed once metrics and err are available.
+	done    chan struct{}
+	metrics []metricapi.Metric
+	err     error
+}
+
+// newMetricDownloader returns a metric downloader running at most maxConcurrent downloads at
+// the same time.
+func newMetricDownloader(maxConcurrent int) *metricDownloader {
+	return &metricDownloader{
+		downloadSlots: make(chan struct{}, maxConcurrent),
+		inFlight:      map[string]*inFlightDownload{},
+	}
+}
+
+// Download downloads a metric for given selectors using provided metric client. It behaves like
+// MetricClient.DownloadMetric, but an identical download that is already in flight is not
+// repeated - its result is shared - and the number of downloads running concurrently is bounded.
+func (self *metricDownloader) Download(metricClient metricapi.MetricClient,
+	selectors []metricapi.ResourceSelector, metricName string,
+	cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.NewMetricPromises(len(selectors))
+	key := downloadKey(selectors, metricName)
+
+	self.mutex.Lock()
+	download, isInFlight := self.inFlight[key]
+	if !isInFlight {
+		download = &inFlightDownload{done: make(chan struct{})}
+		self.inFlight[key] = download
+		go func() {
+			self.downloadSlots <- struct{}{}
+			metrics, err := metricClient.DownloadMetric(selectors, metricName,
+				cachedResources).GetMetrics()
+			<-self.downloadSlots
+
+			download.metrics = metrics
+			download.err = err
+			self.mutex.Lock()
+			delete(self.inFlight, key)
+			self.mutex.Unlock()
+			close(download.done)
+		}()
+	}
+	self.mutex.Unlock()
+
+	go func() {
+		<-download.done
+		if download.err == nil && len(download.metrics) != len(result) {
+			result.PutMetrics(nil, fmt.Errorf("Received invalid number of metrics. "+
+				"Expected %d received %d", len(result), len(download.metrics)))
+			return
+		}
+		result.PutMetrics(download.metrics, download.err)
+	}()
+	return result
+}
+
+// downloadKey returns a key identifying a metric download. Downloads of the same metric for the
+// same list of resources are identical - they can share a single result.
+func downloadKey(selectors []metricapi.ResourceSelector, metricName string) string {
+	key := metricName
+	for _, selector := range selectors {
+		key += fmt.Sprintf("|%s/%s/%s/%s", selector.Namespace, selector.ResourceType,
+			selector.ResourceName, selector.UID)
+	}
+	return key
+}
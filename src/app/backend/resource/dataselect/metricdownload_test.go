@@ -0,0 +1,149 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// fakeMetricClient counts DownloadMetric calls and how many of them run at the same time.
+type fakeMetricClient struct {
+	mutex sync.Mutex
+	// calls is the total number of DownloadMetric calls.
+	calls int
+	// concurrent is the number of DownloadMetric calls running right now.
+	concurrent int
+	// maxConcurrent is the largest value concurrent has reached.
+	maxConcurrent int
+	// proceed, when not nil, blocks every DownloadMetric call until the channel is closed.
+	proceed chan struct{}
+}
+
+func (self *fakeMetricClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	self.mutex.Lock()
+	self.calls++
+	self.concurrent++
+	if self.concurrent > self.maxConcurrent {
+		self.maxConcurrent = self.concurrent
+	}
+	self.mutex.Unlock()
+
+	if self.proceed != nil {
+		<-self.proceed
+	} else {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	self.mutex.Lock()
+	self.concurrent--
+	self.mutex.Unlock()
+
+	promises := metricapi.NewMetricPromises(len(selectors))
+	promises.PutMetrics(make([]metricapi.Metric, len(selectors)), nil)
+	return promises
+}
+
+func (self *fakeMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.MetricPromises{}
+	for _, metricName := range metricNames {
+		result = append(result, self.DownloadMetric(selectors, metricName, cachedResources)...)
+	}
+	return result
+}
+
+func (self *fakeMetricClient) AggregateMetrics(metrics metricapi.MetricPromises,
+	metricName string, aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return metrics
+}
+
+func (self *fakeMetricClient) HealthCheck() error { return nil }
+
+func (self *fakeMetricClient) ID() integrationapi.IntegrationID {
+	return integrationapi.IntegrationID("fakeMetricClient")
+}
+
+func podSelectors(namespace string) []metricapi.ResourceSelector {
+	return []metricapi.ResourceSelector{
+		{
+			Namespace:    namespace,
+			ResourceType: api.ResourceKindPod,
+			ResourceName: "pod-1",
+			UID:          "uid-1",
+		},
+	}
+}
+
+func TestMetricDownloaderSharesIdenticalDownloads(t *testing.T) {
+	metricClient := &fakeMetricClient{proceed: make(chan struct{})}
+	downloader := newMetricDownloader(maxConcurrentMetricDownloads)
+
+	promisesList := make([]metricapi.MetricPromises, 20)
+	for i := range promisesList {
+		promisesList[i] = downloader.Download(metricClient, podSelectors("ns-1"),
+			metricapi.CpuUsage, metricapi.NoResourceCache)
+	}
+	close(metricClient.proceed)
+
+	for _, promises := range promisesList {
+		metrics, err := promises.GetMetrics()
+		if err != nil {
+			t.Fatalf("Download(...) promises returned error: %s", err)
+		}
+		if len(metrics) != 1 {
+			t.Fatalf("Expected 1 metric from every download but got %d", len(metrics))
+		}
+	}
+
+	if metricClient.calls != 1 {
+		t.Errorf("Expected 1 metric client call for identical downloads but got %d",
+			metricClient.calls)
+	}
+}
+
+func TestMetricDownloaderBoundsConcurrentDownloads(t *testing.T) {
+	metricClient := &fakeMetricClient{}
+	downloader := newMetricDownloader(2)
+
+	promisesList := make([]metricapi.MetricPromises, 10)
+	for i := range promisesList {
+		promisesList[i] = downloader.Download(metricClient,
+			podSelectors(fmt.Sprintf("ns-%d", i)), metricapi.CpuUsage,
+			metricapi.NoResourceCache)
+	}
+
+	for _, promises := range promisesList {
+		if _, err := promises.GetMetrics(); err != nil {
+			t.Fatalf("Download(...) promises returned error: %s", err)
+		}
+	}
+
+	if metricClient.calls != 10 {
+		t.Errorf("Expected 10 metric client calls for distinct downloads but got %d",
+			metricClient.calls)
+	}
+	if metricClient.maxConcurrent > 2 {
+		t.Errorf("Expected at most 2 concurrent metric client calls but got %d",
+			metricClient.maxConcurrent)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"log"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// redactedSecretValue replaces secret data in an exported bundle unless secrets were
+// explicitly requested.
+const redactedSecretValue = "REDACTED"
+
+// GetDeploymentBundle exports a deployment together with the services targeting its pods and
+// the config maps and secrets its pod template references, as a multi-document YAML bundle.
+// Secret data is redacted unless withSecrets is set.
+func GetDeploymentBundle(client client.Interface, namespace, deploymentName string,
+	withSecrets bool) (string, error) {
+	log.Printf("Exporting bundle of %s deployment in %s namespace", deploymentName, namespace)
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	deployment.TypeMeta = metaV1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1beta2"}
+	objects := []interface{}{deployment}
+
+	services, err := client.CoreV1().Services(namespace).List(api.ListEverything)
+	if err != nil {
+		return "", err
+	}
+	for _, service := range common.FilterNamespacedServicesBySelector(services.Items, namespace,
+		deployment.Spec.Template.Labels) {
+		service.TypeMeta = metaV1.TypeMeta{Kind: "Service", APIVersion: "v1"}
+		objects = append(objects, service)
+	}
+
+	podSpec := &deployment.Spec.Template.Spec
+	for _, name := range referencedConfigMapNames(podSpec) {
+		configMap, err := client.CoreV1().ConfigMaps(namespace).Get(name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		configMap.TypeMeta = metaV1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"}
+		objects = append(objects, configMap)
+	}
+	for _, name := range referencedSecretNames(podSpec) {
+		secret, err := client.CoreV1().Secrets(namespace).Get(name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		secret.TypeMeta = metaV1.TypeMeta{Kind: "Secret", APIVersion: "v1"}
+		if !withSecrets {
+			redactSecret(secret)
+		}
+		objects = append(objects, secret)
+	}
+
+	return marshalBundle(objects)
+}
+
+// referencedConfigMapNames collects the names of config maps referenced by the volumes and
+// container environment of a pod spec, without duplicates.
+func referencedConfigMapNames(podSpec *v1.PodSpec) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, volume := range podSpec.Volumes {
+		if volume.ConfigMap != nil {
+			add(volume.ConfigMap.Name)
+		}
+	}
+	for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(envFrom.ConfigMapRef.Name)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+				add(env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+		}
+	}
+	return names
+}
+
+// referencedSecretNames collects the names of secrets referenced by the volumes, image pull
+// secrets and container environment of a pod spec, without duplicates.
+func referencedSecretNames(podSpec *v1.PodSpec) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, volume := range podSpec.Volumes {
+		if volume.Secret != nil {
+			add(volume.Secret.SecretName)
+		}
+	}
+	for _, pullSecret := range podSpec.ImagePullSecrets {
+		add(pullSecret.Name)
+	}
+	for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				add(envFrom.SecretRef.Name)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				add(env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+	return names
+}
+
+// redactSecret replaces the values of a secret with a placeholder, keeping the keys so the
+// exported bundle still documents what the secret must contain.
+func redactSecret(secret *v1.Secret) {
+	for key := range secret.Data {
+		secret.Data[key] = []byte(redactedSecretValue)
+	}
+	for key := range secret.StringData {
+		secret.StringData[key] = redactedSecretValue
+	}
+}
+
+// marshalBundle serializes objects into a single multi-document YAML string.
+func marshalBundle(objects []interface{}) (string, error) {
+	documents := make([]string, 0, len(objects))
+	for _, object := range objects {
+		document, err := yaml.Marshal(object)
+		if err != nil {
+			return "", err
+		}
+		documents = append(documents, string(document))
+	}
+	return strings.Join(documents, "---\n"), nil
+}
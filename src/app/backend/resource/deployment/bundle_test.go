@@ -0,0 +1,117 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetDeploymentBundle(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	fakeClient := fake.NewSimpleClientset(
+		&apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "dp-1", Namespace: "ns-1", Labels: labels},
+			Spec: apps.DeploymentSpec{
+				Selector: &metaV1.LabelSelector{MatchLabels: labels},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metaV1.ObjectMeta{Labels: labels},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "web", Image: "web:1",
+								Env: []v1.EnvVar{
+									{
+										Name: "TOKEN",
+										ValueFrom: &v1.EnvVarSource{
+											SecretKeyRef: &v1.SecretKeySelector{
+												LocalObjectReference: v1.LocalObjectReference{
+													Name: "secret-1"},
+												Key: "token",
+											},
+										},
+									},
+								},
+							},
+						},
+						Volumes: []v1.Volume{
+							{
+								Name: "config",
+								VolumeSource: v1.VolumeSource{
+									ConfigMap: &v1.ConfigMapVolumeSource{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "config-1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+			Spec:       v1.ServiceSpec{Selector: labels},
+		},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Name: "svc-other", Namespace: "ns-1"},
+			Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "other"}},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metaV1.ObjectMeta{Name: "config-1", Namespace: "ns-1"},
+			Data:       map[string]string{"setting": "value"},
+		},
+		&v1.Secret{
+			ObjectMeta: metaV1.ObjectMeta{Name: "secret-1", Namespace: "ns-1"},
+			Data:       map[string][]byte{"token": []byte("top-secret")},
+		},
+	)
+
+	bundle, err := GetDeploymentBundle(fakeClient, "ns-1", "dp-1", false)
+	if err != nil {
+		t.Fatalf("GetDeploymentBundle(client, ns-1, dp-1, false) returned error: %s", err)
+	}
+
+	for _, expected := range []string{"kind: Deployment", "name: dp-1", "kind: Service",
+		"name: svc-1", "kind: ConfigMap", "name: config-1", "kind: Secret", "name: secret-1"} {
+		if !strings.Contains(bundle, expected) {
+			t.Errorf("GetDeploymentBundle(client, ns-1, dp-1, false) bundle does not contain %q:\n%s",
+				expected, bundle)
+		}
+	}
+	if strings.Contains(bundle, "svc-other") {
+		t.Error("Bundle contains service svc-other that does not target the deployment")
+	}
+	if strings.Contains(bundle, base64.StdEncoding.EncodeToString([]byte("top-secret"))) {
+		t.Error("Bundle contains unredacted secret data without withSecrets")
+	}
+	if !strings.Contains(bundle, base64.StdEncoding.EncodeToString([]byte(redactedSecretValue))) {
+		t.Error("Bundle does not contain the redacted secret placeholder")
+	}
+
+	bundle, err = GetDeploymentBundle(fakeClient, "ns-1", "dp-1", true)
+	if err != nil {
+		t.Fatalf("GetDeploymentBundle(client, ns-1, dp-1, true) returned error: %s", err)
+	}
+	if !strings.Contains(bundle, base64.StdEncoding.EncodeToString([]byte("top-secret"))) {
+		t.Error("Bundle does not contain secret data with withSecrets")
+	}
+}
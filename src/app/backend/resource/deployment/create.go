@@ -0,0 +1,171 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/validation"
+	apps "k8s.io/api/apps/v1beta2"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// DeploymentSpec is a lower-level specification for creating a deployment. Unlike
+// AppDeploymentSpec it exposes the full container list and is not tied to the app
+// deployment wizard.
+type DeploymentSpec struct {
+	// Name of the deployment.
+	Name string `json:"name"`
+
+	// Target namespace of the deployment.
+	Namespace string `json:"namespace"`
+
+	// Number of replicas of the pod template to maintain.
+	Replicas int32 `json:"replicas"`
+
+	// Labels that will be defined on the deployment and its pods.
+	Labels []Label `json:"labels"`
+
+	// Containers of the pod template.
+	Containers []ContainerSpec `json:"containers"`
+}
+
+// ContainerSpec is a specification of a single container of a deployment.
+type ContainerSpec struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Docker image path for the container.
+	Image string `json:"image"`
+
+	// Ports exposed by the container.
+	Ports []ContainerPort `json:"ports"`
+
+	// List of user-defined environment variables.
+	Variables []EnvironmentVariable `json:"variables"`
+
+	// Optional memory requirement for the container.
+	MemoryRequirement *resource.Quantity `json:"memoryRequirement"`
+
+	// Optional CPU requirement for the container.
+	CpuRequirement *resource.Quantity `json:"cpuRequirement"`
+}
+
+// ContainerPort is a port exposed by a single container.
+type ContainerPort struct {
+	// Name of the port, optional.
+	Name string `json:"name"`
+
+	// Port number exposed by the container.
+	ContainerPort int32 `json:"containerPort"`
+
+	// IP protocol for the port, e.g., "TCP" or "UDP".
+	Protocol api.Protocol `json:"protocol"`
+}
+
+// CreateDeployment creates a deployment based on the given specification using the apps client.
+func CreateDeployment(spec *DeploymentSpec, client client.Interface) error {
+	log.Printf("Creating %s deployment in %s namespace", spec.Name, spec.Namespace)
+
+	if err := validateDeploymentSpec(spec); err != nil {
+		return err
+	}
+
+	nameValidity, err := validation.ValidateAppName(&validation.AppNameValiditySpec{
+		Name:      spec.Name,
+		Namespace: spec.Namespace,
+	}, client)
+	if err != nil {
+		return err
+	}
+	if !nameValidity.Valid {
+		return fmt.Errorf("Name %s is already in use in %s namespace", spec.Name, spec.Namespace)
+	}
+
+	labels := getLabelsMap(spec.Labels)
+	objectMeta := metaV1.ObjectMeta{
+		Name:   spec.Name,
+		Labels: labels,
+	}
+
+	containers := make([]api.Container, 0)
+	for _, containerSpec := range spec.Containers {
+		container := api.Container{
+			Name:  containerSpec.Name,
+			Image: containerSpec.Image,
+			Env:   convertEnvVarsSpec(containerSpec.Variables),
+			Resources: api.ResourceRequirements{
+				Requests: make(map[api.ResourceName]resource.Quantity),
+			},
+		}
+		for _, port := range containerSpec.Ports {
+			container.Ports = append(container.Ports, api.ContainerPort{
+				Name:          port.Name,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+			})
+		}
+		if containerSpec.CpuRequirement != nil {
+			container.Resources.Requests[api.ResourceCPU] = *containerSpec.CpuRequirement
+		}
+		if containerSpec.MemoryRequirement != nil {
+			container.Resources.Requests[api.ResourceMemory] = *containerSpec.MemoryRequirement
+		}
+		containers = append(containers, container)
+	}
+
+	deployment := &apps.Deployment{
+		ObjectMeta: objectMeta,
+		Spec: apps.DeploymentSpec{
+			Replicas: &spec.Replicas,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: objectMeta,
+				Spec:       api.PodSpec{Containers: containers},
+			},
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: labels,
+			},
+		},
+	}
+
+	_, err = client.AppsV1beta2().Deployments(spec.Namespace).Create(deployment)
+	return err
+}
+
+// validateDeploymentSpec checks that the deployment has at least one container and that all
+// image references are valid.
+func validateDeploymentSpec(spec *DeploymentSpec) error {
+	if len(spec.Containers) == 0 {
+		return fmt.Errorf("Deployment %s must have at least one container", spec.Name)
+	}
+
+	for _, container := range spec.Containers {
+		validity, err := validation.ValidateImageReference(&validation.ImageReferenceValiditySpec{
+			Reference: container.Image,
+		})
+		if err != nil {
+			return err
+		}
+		if !validity.Valid {
+			return fmt.Errorf("Invalid image reference %s: %s", container.Image, validity.Reason)
+		}
+	}
+
+	return nil
+}
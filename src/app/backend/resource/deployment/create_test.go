@@ -0,0 +1,106 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func TestCreateDeployment(t *testing.T) {
+	namespace := "foo-namespace"
+	spec := &DeploymentSpec{
+		Name:      "foo-name",
+		Namespace: namespace,
+		Replicas:  2,
+		Labels:    []Label{{Key: "app", Value: "foo"}},
+		Containers: []ContainerSpec{
+			{
+				Name:      "foo-web",
+				Image:     "library/nginx",
+				Ports:     []ContainerPort{{Name: "http", ContainerPort: 80, Protocol: "TCP"}},
+				Variables: []EnvironmentVariable{{Name: "MODE", Value: "web"}},
+			},
+			{
+				Name:  "foo-sidecar",
+				Image: "library/busybox",
+			},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := CreateDeployment(spec, testClient)
+	if err != nil {
+		t.Errorf("CreateDeployment(%#v) returned error: %s", spec, err)
+	}
+
+	var deployment *apps.Deployment
+	for _, action := range testClient.Actions() {
+		createAction, ok := action.(core.CreateActionImpl)
+		if !ok {
+			continue
+		}
+		if obj, ok := createAction.GetObject().(*apps.Deployment); ok {
+			deployment = obj
+		}
+	}
+
+	if deployment == nil {
+		t.Fatalf("CreateDeployment(%#v) did not create a deployment", spec)
+	}
+
+	if deployment.Name != "foo-name" {
+		t.Errorf("CreateDeployment(%#v) created deployment with name %s, expected foo-name",
+			spec, deployment.Name)
+	}
+	if *deployment.Spec.Replicas != 2 {
+		t.Errorf("CreateDeployment(%#v) created deployment with %d replicas, expected 2",
+			spec, *deployment.Spec.Replicas)
+	}
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) != 2 {
+		t.Fatalf("CreateDeployment(%#v) created deployment with %d containers, expected 2",
+			spec, len(containers))
+	}
+	if containers[0].Image != "library/nginx" || containers[1].Image != "library/busybox" {
+		t.Errorf("CreateDeployment(%#v) created deployment with unexpected images: %#v",
+			spec, containers)
+	}
+	if len(containers[0].Ports) != 1 || containers[0].Ports[0].ContainerPort != 80 {
+		t.Errorf("CreateDeployment(%#v) created deployment with unexpected ports: %#v",
+			spec, containers[0].Ports)
+	}
+}
+
+func TestCreateDeploymentWithInvalidImage(t *testing.T) {
+	spec := &DeploymentSpec{
+		Name:      "foo-name",
+		Namespace: "foo-namespace",
+		Containers: []ContainerSpec{
+			{Name: "foo", Image: "Invalid image reference!"},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := CreateDeployment(spec, testClient)
+	if err == nil {
+		t.Errorf("CreateDeployment(%#v) expected invalid image reference error, got nil", spec)
+	}
+}
@@ -69,6 +69,14 @@ type AppDeploymentSpec struct {
 	// List of user-defined environment variables.
 	Variables []EnvironmentVariable `json:"variables"`
 
+	// Names of existing config maps in the target namespace that the container environment is
+	// populated from.
+	EnvFromConfigMaps []string `json:"envFromConfigMaps"`
+
+	// Names of existing secrets in the target namespace that the container environment is
+	// populated from.
+	EnvFromSecrets []string `json:"envFromSecrets"`
+
 	// Whether the created service is external.
 	IsExternal bool `json:"isExternal"`
 
@@ -89,6 +97,12 @@ type AppDeploymentSpec struct {
 
 	// Whether to run the container as privileged user (essentially equivalent to root on the host).
 	RunAsPrivileged bool `json:"runAsPrivileged"`
+
+	// Optional node selector that the created pods must match to be scheduled.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// Optional tolerations of the created pods.
+	Tolerations []api.Toleration `json:"tolerations"`
 }
 
 // AppDeploymentFromFileSpec is a specification for deployment from file
@@ -156,9 +170,11 @@ type Protocols struct {
 
 // DeployApp deploys an app based on the given configuration. The app is deployed using the given
 // client. App deployment consists of a deployment and an optional service. Both of them
-// share common labels.
-func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
-	log.Printf("Deploying %s application into %s namespace", spec.Name, spec.Namespace)
+// share common labels. When dryRun is set, the objects are only built and validated and nothing
+// is persisted, so that the caller can preview what would be created.
+func DeployApp(spec *AppDeploymentSpec, client client.Interface, dryRun bool) error {
+	log.Printf("Deploying %s application into %s namespace (dry run: %t)", spec.Name,
+		spec.Namespace, dryRun)
 
 	annotations := map[string]string{}
 	if spec.Description != nil {
@@ -171,6 +187,18 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 		Labels:      labels,
 	}
 
+	if err := validateEnvFromSources(spec, client); err != nil {
+		return err
+	}
+
+	if err := validateTolerations(spec.Tolerations); err != nil {
+		return err
+	}
+
+	if err := validatePortMappings(spec.PortMappings); err != nil {
+		return err
+	}
+
 	containerSpec := api.Container{
 		Name:  spec.Name,
 		Image: spec.ContainerImage,
@@ -180,7 +208,8 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 		Resources: api.ResourceRequirements{
 			Requests: make(map[api.ResourceName]resource.Quantity),
 		},
-		Env: convertEnvVarsSpec(spec.Variables),
+		Env:     convertEnvVarsSpec(spec.Variables),
+		EnvFrom: convertEnvFromSpec(spec.EnvFromConfigMaps, spec.EnvFromSecrets),
 	}
 
 	if spec.ContainerCommand != nil {
@@ -197,7 +226,9 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 		containerSpec.Resources.Requests[api.ResourceMemory] = *spec.MemoryRequirement
 	}
 	podSpec := api.PodSpec{
-		Containers: []api.Container{containerSpec},
+		Containers:   []api.Container{containerSpec},
+		NodeSelector: spec.NodeSelector,
+		Tolerations:  spec.Tolerations,
 	}
 	if spec.ImagePullSecret != nil {
 		podSpec.ImagePullSecrets = []api.LocalObjectReference{{Name: *spec.ImagePullSecret}}
@@ -222,11 +253,13 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 			},
 		},
 	}
-	_, err := client.AppsV1beta2().Deployments(spec.Namespace).Create(deployment)
+	if !dryRun {
+		_, err := client.AppsV1beta2().Deployments(spec.Namespace).Create(deployment)
 
-	if err != nil {
-		// TODO(bryk): Roll back created resources in case of error.
-		return err
+		if err != nil {
+			// TODO(bryk): Roll back created resources in case of error.
+			return err
+		}
 	}
 
 	if len(spec.PortMappings) > 0 {
@@ -257,7 +290,11 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 			service.Spec.Ports = append(service.Spec.Ports, servicePort)
 		}
 
-		_, err = client.CoreV1().Services(spec.Namespace).Create(service)
+		if dryRun {
+			return nil
+		}
+
+		_, err := client.CoreV1().Services(spec.Namespace).Create(service)
 
 		// TODO(bryk): Roll back created resources in case of error.
 		return err
@@ -271,6 +308,88 @@ func GetAvailableProtocols() *Protocols {
 	return &Protocols{Protocols: []api.Protocol{api.ProtocolTCP, api.ProtocolUDP}}
 }
 
+// validateTolerations checks that toleration operators and effects carry supported values.
+// Empty values are allowed and mean "match everything" as in the core API.
+func validateTolerations(tolerations []api.Toleration) error {
+	for _, toleration := range tolerations {
+		switch toleration.Operator {
+		case "", api.TolerationOpExists, api.TolerationOpEqual:
+		default:
+			return fmt.Errorf("Unsupported toleration operator: %s", toleration.Operator)
+		}
+		switch toleration.Effect {
+		case "", api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule, api.TaintEffectNoExecute:
+		default:
+			return fmt.Errorf("Unsupported toleration effect: %s", toleration.Effect)
+		}
+	}
+	return nil
+}
+
+// validatePortMappings checks that all port mappings carry valid port numbers and that no
+// service or target port is used twice. The apiserver rejects such services too, but with
+// messages that do not point at the offending mapping.
+func validatePortMappings(portMappings []PortMapping) error {
+	usedPorts := make(map[int32]bool)
+	usedTargetPorts := make(map[int32]bool)
+	for _, portMapping := range portMappings {
+		if portMapping.Port < 1 || portMapping.Port > 65535 {
+			return fmt.Errorf("Port %d is out of range, must be between 1 and 65535",
+				portMapping.Port)
+		}
+		if portMapping.TargetPort < 1 || portMapping.TargetPort > 65535 {
+			return fmt.Errorf("Target port %d is out of range, must be between 1 and 65535",
+				portMapping.TargetPort)
+		}
+		if usedPorts[portMapping.Port] {
+			return fmt.Errorf("Port %d is mapped more than once", portMapping.Port)
+		}
+		if usedTargetPorts[portMapping.TargetPort] {
+			return fmt.Errorf("Target port %d is mapped more than once", portMapping.TargetPort)
+		}
+		usedPorts[portMapping.Port] = true
+		usedTargetPorts[portMapping.TargetPort] = true
+	}
+	return nil
+}
+
+// validateEnvFromSources checks that all config maps and secrets referenced by the spec exist
+// in the target namespace.
+func validateEnvFromSources(spec *AppDeploymentSpec, client client.Interface) error {
+	for _, name := range spec.EnvFromConfigMaps {
+		if _, err := client.CoreV1().ConfigMaps(spec.Namespace).Get(name, metaV1.GetOptions{}); err != nil {
+			return fmt.Errorf("Config map %s referenced by envFromConfigMaps is not available in %s namespace: %s",
+				name, spec.Namespace, err)
+		}
+	}
+	for _, name := range spec.EnvFromSecrets {
+		if _, err := client.CoreV1().Secrets(spec.Namespace).Get(name, metaV1.GetOptions{}); err != nil {
+			return fmt.Errorf("Secret %s referenced by envFromSecrets is not available in %s namespace: %s",
+				name, spec.Namespace, err)
+		}
+	}
+	return nil
+}
+
+func convertEnvFromSpec(configMaps, secrets []string) []api.EnvFromSource {
+	var result []api.EnvFromSource
+	for _, name := range configMaps {
+		result = append(result, api.EnvFromSource{
+			ConfigMapRef: &api.ConfigMapEnvSource{
+				LocalObjectReference: api.LocalObjectReference{Name: name},
+			},
+		})
+	}
+	for _, name := range secrets {
+		result = append(result, api.EnvFromSource{
+			SecretRef: &api.SecretEnvSource{
+				LocalObjectReference: api.LocalObjectReference{Name: name},
+			},
+		})
+	}
+	return result
+}
+
 func convertEnvVarsSpec(variables []EnvironmentVariable) []api.EnvVar {
 	var result []api.EnvVar
 	for _, variable := range variables {
@@ -305,8 +424,10 @@ func getLabelsMap(labels []Label) map[string]string {
 	return result
 }
 
-// DeployAppFromFile deploys an app based on the given yaml or json file.
-func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec) (bool, error) {
+// DeployAppFromFile deploys an app based on the given yaml or json file. When dryRun is set,
+// the content is only decoded and resolved against the apiserver's discovery information and
+// nothing is persisted.
+func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec, dryRun bool) (bool, error) {
 	reader := strings.NewReader(spec.Content)
 	log.Printf("Namespace for deploy from file: %s\n", spec.Namespace)
 	d := yaml.NewYAMLOrJSONDecoder(reader, 4096)
@@ -358,6 +479,10 @@ func DeployAppFromFile(cfg *rest.Config, spec *AppDeploymentFromFileSpec) (bool,
 			return false, err
 		}
 
+		if dryRun {
+			continue
+		}
+
 		if strings.Compare(spec.Namespace, "_all") == 0 {
 			_, err = dynamicClient.Resource(resource, data.GetNamespace()).Create(&data)
 		} else {
@@ -70,7 +70,7 @@ func TestDeployApp(t *testing.T) {
 
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(spec, testClient, false)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 	if len(testClient.Actions()) != 1 {
@@ -99,7 +99,7 @@ func TestDeployAppContainerCommands(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(spec, testClient, false)
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
 	rc := createAction.GetObject().(*apps.Deployment)
@@ -123,7 +123,7 @@ func TestDeployShouldPopulateEnvVars(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(spec, testClient, false)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
@@ -165,7 +165,7 @@ func TestDeployWithResourceRequirements(t *testing.T) {
 	}
 	testClient := fake.NewSimpleClientset()
 
-	DeployApp(spec, testClient)
+	DeployApp(spec, testClient, false)
 
 	createAction := testClient.Actions()[0].(core.CreateActionImpl)
 
@@ -186,3 +186,189 @@ func TestGetAvailableProtocols(t *testing.T) {
 			expected, actual)
 	}
 }
+
+func TestDeployAppEnvFrom(t *testing.T) {
+	namespace := "foo-namespace"
+	spec := &AppDeploymentSpec{
+		Namespace:         namespace,
+		Name:              "foo-name",
+		EnvFromConfigMaps: []string{"foo-config"},
+		EnvFromSecrets:    []string{"foo-secret"},
+	}
+
+	expectedEnvFrom := []api.EnvFromSource{
+		{
+			ConfigMapRef: &api.ConfigMapEnvSource{
+				LocalObjectReference: api.LocalObjectReference{Name: "foo-config"},
+			},
+		},
+		{
+			SecretRef: &api.SecretEnvSource{
+				LocalObjectReference: api.LocalObjectReference{Name: "foo-secret"},
+			},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset(
+		&api.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Name: "foo-config", Namespace: namespace}},
+		&api.Secret{ObjectMeta: metaV1.ObjectMeta{Name: "foo-secret", Namespace: namespace}},
+	)
+
+	err := DeployApp(spec, testClient, false)
+	if err != nil {
+		t.Errorf("DeployApp(%#v) returned error: %s", spec, err)
+	}
+
+	var deployment *apps.Deployment
+	for _, action := range testClient.Actions() {
+		if createAction, ok := action.(core.CreateActionImpl); ok {
+			if obj, ok := createAction.GetObject().(*apps.Deployment); ok {
+				deployment = obj
+			}
+		}
+	}
+
+	if deployment == nil {
+		t.Fatalf("DeployApp(%#v) did not create a deployment", spec)
+	}
+
+	actualEnvFrom := deployment.Spec.Template.Spec.Containers[0].EnvFrom
+	if !reflect.DeepEqual(actualEnvFrom, expectedEnvFrom) {
+		t.Errorf("DeployApp(%#v) created deployment with envFrom %#v, expected %#v",
+			spec, actualEnvFrom, expectedEnvFrom)
+	}
+}
+
+func TestDeployAppEnvFromMissingSource(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace:         "foo-namespace",
+		Name:              "foo-name",
+		EnvFromConfigMaps: []string{"missing-config"},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, false)
+	if err == nil {
+		t.Errorf("DeployApp(%#v) expected missing config map error, got nil", spec)
+	}
+}
+
+func TestDeployAppDryRun(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		PortMappings: []PortMapping{
+			{Port: 80, TargetPort: 80, Protocol: api.ProtocolTCP},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, true)
+	if err != nil {
+		t.Errorf("DeployApp(%#v) returned error: %s", spec, err)
+	}
+
+	for _, action := range testClient.Actions() {
+		if action.GetVerb() == "create" {
+			t.Errorf("DeployApp(%#v) with dry run created a resource: %#v", spec, action)
+		}
+	}
+}
+
+func TestDeployAppNodeSelectorAndTolerations(t *testing.T) {
+	namespace := "foo-namespace"
+	nodeSelector := map[string]string{"disktype": "ssd"}
+	tolerations := []api.Toleration{
+		{
+			Key:      "dedicated",
+			Operator: api.TolerationOpEqual,
+			Value:    "foo",
+			Effect:   api.TaintEffectNoSchedule,
+		},
+	}
+	spec := &AppDeploymentSpec{
+		Namespace:    namespace,
+		Name:         "foo-name",
+		NodeSelector: nodeSelector,
+		Tolerations:  tolerations,
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, false)
+	if err != nil {
+		t.Errorf("DeployApp(%#v) returned error: %s", spec, err)
+	}
+
+	createAction := testClient.Actions()[0].(core.CreateActionImpl)
+	deployment := createAction.GetObject().(*apps.Deployment)
+	podSpec := deployment.Spec.Template.Spec
+	if !reflect.DeepEqual(podSpec.NodeSelector, nodeSelector) {
+		t.Errorf("DeployApp(%#v) created deployment with node selector %#v, expected %#v",
+			spec, podSpec.NodeSelector, nodeSelector)
+	}
+	if !reflect.DeepEqual(podSpec.Tolerations, tolerations) {
+		t.Errorf("DeployApp(%#v) created deployment with tolerations %#v, expected %#v",
+			spec, podSpec.Tolerations, tolerations)
+	}
+}
+
+func TestDeployAppInvalidToleration(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		Tolerations: []api.Toleration{
+			{Key: "dedicated", Operator: "Like", Effect: api.TaintEffectNoSchedule},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, false)
+	if err == nil {
+		t.Errorf("DeployApp(%#v) expected toleration validation error, got nil", spec)
+	}
+}
+
+func TestDeployAppDuplicatePort(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		PortMappings: []PortMapping{
+			{Port: 80, TargetPort: 8080, Protocol: api.ProtocolTCP},
+			{Port: 80, TargetPort: 9090, Protocol: api.ProtocolTCP},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, false)
+	if err == nil {
+		t.Errorf("DeployApp(%#v) expected duplicate port error, got nil", spec)
+	}
+
+	for _, action := range testClient.Actions() {
+		if action.GetVerb() == "create" {
+			t.Errorf("DeployApp(%#v) with invalid ports created a resource: %#v", spec, action)
+		}
+	}
+}
+
+func TestDeployAppPortOutOfRange(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		PortMappings: []PortMapping{
+			{Port: 80, TargetPort: 65536, Protocol: api.ProtocolTCP},
+		},
+	}
+
+	testClient := fake.NewSimpleClientset()
+
+	err := DeployApp(spec, testClient, false)
+	if err == nil {
+		t.Errorf("DeployApp(%#v) expected port range validation error, got nil", spec)
+	}
+}
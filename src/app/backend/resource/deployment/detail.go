@@ -16,6 +16,7 @@ package deployment
 
 import (
 	"log"
+	"sort"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
@@ -27,11 +28,16 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/replicaset"
 	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	client "k8s.io/client-go/kubernetes"
 )
 
+// failedSchedulingReason is the reason of events the scheduler emits when it cannot place
+// a pod on any node.
+const failedSchedulingReason = "FailedScheduling"
+
 // RollingUpdateStrategy is behavior of a rolling update. See RollingUpdateDeployment K8s object.
 type RollingUpdateStrategy struct {
 	MaxSurge       *intstr.IntOrString `json:"maxSurge"`
@@ -67,6 +73,14 @@ type DeploymentDetail struct {
 	// Status information on the deployment
 	StatusInfo `json:"statusInfo"`
 
+	// Progress of an ongoing rollout as a number between 0 and 100, based on how many
+	// replicas are updated and available. A finished rollout reports 100.
+	ProgressPercentage int32 `json:"progressPercentage"`
+
+	// BelowThreshold is true when the deployment has fewer available replicas than the
+	// min-available threshold set in its annotation. False when no threshold is set.
+	BelowThreshold bool `json:"belowThreshold"`
+
 	// The deployment strategy to use to replace existing pods with new ones.
 	// Valid options: Recreate, RollingUpdate
 	Strategy apps.DeploymentStrategyType `json:"strategy"`
@@ -89,6 +103,11 @@ type DeploymentDetail struct {
 	// List of events related to this Deployment
 	EventList common.EventList `json:"eventList"`
 
+	// Unique messages of FailedScheduling events of the deployment's pending pods. Explains
+	// why a rollout is stuck (insufficient resources, taints, affinity) without digging
+	// through events.
+	SchedulingFailures []string `json:"schedulingFailures"`
+
 	// List of Horizontal Pod AutoScalers targeting this Deployment
 	HorizontalPodAutoscalerList hpa.HorizontalPodAutoscalerList `json:"horizontalPodAutoscalerList"`
 
@@ -146,6 +165,12 @@ func GetDeploymentDetail(client client.Interface, metricClient metricapi.MetricC
 		return nil, criticalError
 	}
 
+	schedulingFailures, err := getSchedulingFailures(client, namespace, rawPods.Items)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
 	hpas, err := hpa.GetHorizontalPodAutoscalerListForResource(client, namespace, "Deployment", deploymentName)
 	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
 	if criticalError != nil {
@@ -197,6 +222,8 @@ func GetDeploymentDetail(client client.Interface, metricClient metricapi.MetricC
 		PodList:                     *podList,
 		Selector:                    deployment.Spec.Selector.MatchLabels,
 		StatusInfo:                  GetStatusInfo(&deployment.Status),
+		ProgressPercentage:          getProgressPercentage(&deployment.Status),
+		BelowThreshold:              isBelowThreshold(deployment),
 		Strategy:                    deployment.Spec.Strategy.Type,
 		MinReadySeconds:             deployment.Spec.MinReadySeconds,
 		RollingUpdateStrategy:       rollingUpdateStrategy,
@@ -204,12 +231,46 @@ func GetDeploymentDetail(client client.Interface, metricClient metricapi.MetricC
 		NewReplicaSet:               newReplicaSet,
 		RevisionHistoryLimit:        deployment.Spec.RevisionHistoryLimit,
 		EventList:                   *eventList,
+		SchedulingFailures:          schedulingFailures,
 		HorizontalPodAutoscalerList: *hpas,
 		Errors: nonCriticalErrors,
 	}, nil
 
 }
 
+// getSchedulingFailures aggregates the unique FailedScheduling event messages of those of the
+// given pods that are still pending.
+func getSchedulingFailures(client client.Interface, namespace string, pods []v1.Pod) ([]string, error) {
+	failures := make([]string, 0)
+
+	pendingPods := make([]v1.Pod, 0)
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodPending {
+			pendingPods = append(pendingPods, pod)
+		}
+	}
+	if len(pendingPods) == 0 {
+		return failures, nil
+	}
+
+	events, err := event.GetPodsEvents(client, namespace, pendingPods)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, podEvent := range events {
+		if podEvent.Reason != failedSchedulingReason || seen[podEvent.Message] {
+			continue
+		}
+		seen[podEvent.Message] = true
+		failures = append(failures, podEvent.Message)
+	}
+	sort.Strings(failures)
+
+	return failures, nil
+}
+
 func GetStatusInfo(deploymentStatus *apps.DeploymentStatus) StatusInfo {
 	return StatusInfo{
 		Replicas:    deploymentStatus.Replicas,
@@ -218,3 +279,31 @@ func GetStatusInfo(deploymentStatus *apps.DeploymentStatus) StatusInfo {
 		Unavailable: deploymentStatus.UnavailableReplicas,
 	}
 }
+
+// getProgressPercentage computes rollout progress of the deployment as a number between 0 and
+// 100. Updated and available replicas each contribute half, so the number keeps growing while
+// new pods are created and then become ready. A deployment scaled to zero reports 100, as
+// there is nothing left to roll out.
+func getProgressPercentage(deploymentStatus *apps.DeploymentStatus) int32 {
+	replicas := int64(deploymentStatus.Replicas)
+	if replicas == 0 {
+		return 100
+	}
+
+	// During a surge the total can temporarily exceed the desired count, so cap both
+	// contributions at the number of replicas.
+	updated := int64(deploymentStatus.UpdatedReplicas)
+	if updated > replicas {
+		updated = replicas
+	}
+	available := int64(deploymentStatus.AvailableReplicas)
+	if available > replicas {
+		available = replicas
+	}
+
+	percentage := int32((updated + available) * 100 / (2 * replicas))
+	if percentage > 100 {
+		percentage = 100
+	}
+	return percentage
+}
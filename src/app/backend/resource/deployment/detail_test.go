@@ -127,6 +127,7 @@ func TestGetDeploymentDetail(t *testing.T) {
 					Available:   3,
 					Unavailable: 1,
 				},
+				ProgressPercentage: 62,
 				Strategy:        "RollingUpdate",
 				MinReadySeconds: 5,
 				RollingUpdateStrategy: &RollingUpdateStrategy{
@@ -149,6 +150,7 @@ func TestGetDeploymentDetail(t *testing.T) {
 				EventList: common.EventList{
 					Events: []common.Event{},
 				},
+				SchedulingFailures: []string{},
 				HorizontalPodAutoscalerList: horizontalpodautoscaler.HorizontalPodAutoscalerList{
 					HorizontalPodAutoscalers: []horizontalpodautoscaler.HorizontalPodAutoscaler{},
 					Errors: []error{},
@@ -182,3 +184,84 @@ func TestGetDeploymentDetail(t *testing.T) {
 		}
 	}
 }
+
+func TestGetSchedulingFailures(t *testing.T) {
+	pendingPod := v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1", UID: "uid-1"},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+	runningPod := v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-2", Namespace: "ns-1", UID: "uid-2"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+	eventList := &v1.EventList{Items: []v1.Event{
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-1"},
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-2", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-1"},
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-3", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-1"},
+			Reason:         "Pulling",
+			Message:        "pulling image",
+		},
+	}}
+
+	fakeClient := fake.NewSimpleClientset(eventList)
+
+	failures, err := getSchedulingFailures(fakeClient, "ns-1", []v1.Pod{pendingPod, runningPod})
+	if err != nil {
+		t.Fatalf("getSchedulingFailures(client, ns-1, pods) returned error: %s", err)
+	}
+
+	expected := []string{"0/3 nodes are available: 3 Insufficient cpu."}
+	if !reflect.DeepEqual(failures, expected) {
+		t.Errorf("getSchedulingFailures(client, ns-1, pods) == \ngot %#v, \nexpected %#v",
+			failures, expected)
+	}
+}
+
+func TestGetProgressPercentage(t *testing.T) {
+	cases := []struct {
+		info     string
+		status   *apps.DeploymentStatus
+		expected int32
+	}{
+		{
+			"mid-rollout deployment reports partial progress",
+			&apps.DeploymentStatus{Replicas: 4, UpdatedReplicas: 2, AvailableReplicas: 3},
+			62,
+		},
+		{
+			"finished rollout reports 100",
+			&apps.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			100,
+		},
+		{
+			"zero-replica deployment reports 100",
+			&apps.DeploymentStatus{},
+			100,
+		},
+		{
+			"surging replicas do not push progress over 100",
+			&apps.DeploymentStatus{Replicas: 3, UpdatedReplicas: 4, AvailableReplicas: 4},
+			100,
+		},
+	}
+
+	for _, c := range cases {
+		actual := getProgressPercentage(c.status)
+		if actual != c.expected {
+			t.Errorf("getProgressPercentage(%#v) == %d, expected %d (%s)", c.status, actual,
+				c.expected, c.info)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// DeploymentRef points at a single deployment.
+type DeploymentRef struct {
+	// Namespace of the deployment.
+	Namespace string `json:"namespace"`
+
+	// Name of the deployment.
+	Name string `json:"name"`
+}
+
+// DeploymentDiffSpec is a specification for comparing two deployments, e.g. the same
+// application deployed to staging and production.
+type DeploymentDiffSpec struct {
+	// First deployment to compare.
+	Left DeploymentRef `json:"left"`
+
+	// Second deployment to compare.
+	Right DeploymentRef `json:"right"`
+}
+
+// FieldDiff is a single pod template field that differs between the compared deployments.
+// Values are rendered as strings so the frontend can show them side by side.
+type FieldDiff struct {
+	// Path of the differing field, e.g. "containers/nginx/image".
+	Field string `json:"field"`
+
+	// Value of the field in the left deployment, empty when the field is absent there.
+	Left string `json:"left"`
+
+	// Value of the field in the right deployment, empty when the field is absent there.
+	Right string `json:"right"`
+}
+
+// DeploymentDiff is a structured comparison of the pod templates of two deployments.
+type DeploymentDiff struct {
+	// First compared deployment.
+	Left DeploymentRef `json:"left"`
+
+	// Second compared deployment.
+	Right DeploymentRef `json:"right"`
+
+	// Fields that differ between the two deployments. Empty when the pod templates match.
+	Diffs []FieldDiff `json:"diffs"`
+}
+
+// DiffDeployments compares the pod templates of two deployments and returns the fields that
+// differ: replica counts and, per container, image, env and resource requirements.
+func DiffDeployments(client client.Interface, spec *DeploymentDiffSpec) (*DeploymentDiff, error) {
+	log.Printf("Comparing %s deployment in %s namespace with %s deployment in %s namespace",
+		spec.Left.Name, spec.Left.Namespace, spec.Right.Name, spec.Right.Namespace)
+
+	left, err := client.AppsV1beta2().Deployments(spec.Left.Namespace).Get(spec.Left.Name,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := client.AppsV1beta2().Deployments(spec.Right.Namespace).Get(spec.Right.Name,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &DeploymentDiff{Left: spec.Left, Right: spec.Right, Diffs: make([]FieldDiff, 0)}
+	diff.appendDiff("replicas", replicasString(left), replicasString(right))
+
+	leftContainers := containersByName(left)
+	rightContainers := containersByName(right)
+	for _, name := range containerNames(leftContainers, rightContainers) {
+		leftContainer, inLeft := leftContainers[name]
+		rightContainer, inRight := rightContainers[name]
+		if !inLeft || !inRight {
+			diff.appendDiff("containers/"+name, presenceString(inLeft), presenceString(inRight))
+			continue
+		}
+
+		diff.appendDiff("containers/"+name+"/image", leftContainer.Image, rightContainer.Image)
+		diff.appendDiff("containers/"+name+"/env", envString(leftContainer),
+			envString(rightContainer))
+		diff.appendDiff("containers/"+name+"/resources", resourcesString(leftContainer),
+			resourcesString(rightContainer))
+	}
+
+	return diff, nil
+}
+
+func (self *DeploymentDiff) appendDiff(field, left, right string) {
+	if left != right {
+		self.Diffs = append(self.Diffs, FieldDiff{Field: field, Left: left, Right: right})
+	}
+}
+
+func replicasString(deployment *apps.Deployment) string {
+	if deployment.Spec.Replicas == nil {
+		return "1"
+	}
+	return fmt.Sprintf("%d", *deployment.Spec.Replicas)
+}
+
+func containersByName(deployment *apps.Deployment) map[string]v1.Container {
+	containers := map[string]v1.Container{}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		containers[container.Name] = container
+	}
+	return containers
+}
+
+// containerNames returns a sorted union of container names of both compared deployments, so
+// the resulting diff has a stable order.
+func containerNames(left, right map[string]v1.Container) []string {
+	names := make([]string, 0)
+	for name := range left {
+		names = append(names, name)
+	}
+	for name := range right {
+		if _, exists := left[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func presenceString(present bool) string {
+	if present {
+		return "present"
+	}
+	return "absent"
+}
+
+func envString(container v1.Container) string {
+	env := make([]string, 0)
+	for _, envVar := range container.Env {
+		value := envVar.Value
+		if envVar.ValueFrom != nil {
+			value = fmt.Sprintf("%+v", *envVar.ValueFrom)
+		}
+		env = append(env, envVar.Name+"="+value)
+	}
+	return strings.Join(env, ",")
+}
+
+func resourcesString(container v1.Container) string {
+	return "requests=" + resourceListString(container.Resources.Requests) +
+		",limits=" + resourceListString(container.Resources.Limits)
+}
+
+func resourceListString(resources v1.ResourceList) string {
+	entries := make([]string, 0)
+	for name, quantity := range resources {
+		entries = append(entries, string(name)+"="+quantity.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDiffDeployments(t *testing.T) {
+	replicas := int32(3)
+	left := testDeployment("staging", "test-deployment")
+	right := testDeployment("production", "test-deployment")
+	right.Spec.Replicas = &replicas
+	right.Spec.Template.Spec.Containers[0].Image = "app-image:2"
+
+	fakeClient := fake.NewSimpleClientset(left, right)
+	spec := &DeploymentDiffSpec{
+		Left:  DeploymentRef{Namespace: "staging", Name: "test-deployment"},
+		Right: DeploymentRef{Namespace: "production", Name: "test-deployment"},
+	}
+
+	diff, err := DiffDeployments(fakeClient, spec)
+	if err != nil {
+		t.Fatalf("DiffDeployments(client, %+v) returned error: %s", spec, err)
+	}
+
+	expected := []FieldDiff{
+		{Field: "replicas", Left: "1", Right: "3"},
+		{Field: "containers/app/image", Left: "app-image:1", Right: "app-image:2"},
+	}
+	if !reflect.DeepEqual(diff.Diffs, expected) {
+		t.Errorf("DiffDeployments(client, %+v).Diffs == \ngot %#v, \nexpected %#v", spec,
+			diff.Diffs, expected)
+	}
+}
+
+func TestDiffDeploymentsMissingDeployment(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(testDeployment("staging", "test-deployment"))
+	spec := &DeploymentDiffSpec{
+		Left:  DeploymentRef{Namespace: "staging", Name: "test-deployment"},
+		Right: DeploymentRef{Namespace: "production", Name: "test-deployment"},
+	}
+
+	_, err := DiffDeployments(fakeClient, spec)
+	if err == nil {
+		t.Errorf("DiffDeployments(client, %+v) returned no error, expected not found error", spec)
+	}
+}
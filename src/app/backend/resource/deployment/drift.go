@@ -0,0 +1,131 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"log"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// DeploymentDrift reports which running pods of a deployment are still on an outdated pod
+// template, showing how far a rollout has progressed.
+type DeploymentDrift struct {
+	// Names of running pods already matching the current pod template.
+	UpdatedPods []string `json:"updatedPods"`
+
+	// Running pods whose spec differs from the current pod template.
+	OutdatedPods []PodDrift `json:"outdatedPods"`
+}
+
+// PodDrift describes how a single running pod differs from the current pod template of its
+// deployment.
+type PodDrift struct {
+	// Name of the pod.
+	Name string `json:"name"`
+
+	// Human readable differences between the pod and the current template.
+	Differences []string `json:"differences"`
+}
+
+// GetDeploymentDrift compares the current pod template of a deployment against the specs of
+// its running pods and reports which pods are outdated.
+func GetDeploymentDrift(client client.Interface, namespace, deploymentName string) (
+	*DeploymentDrift, error) {
+	log.Printf("Detecting config drift of %s deployment in %s namespace", deploymentName, namespace)
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := metaV1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := client.CoreV1().Pods(namespace).List(metaV1.ListOptions{
+		LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &DeploymentDrift{
+		UpdatedPods:  make([]string, 0),
+		OutdatedPods: make([]PodDrift, 0),
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+		differences := podTemplateDrift(&deployment.Spec.Template.Spec, &pod.Spec)
+		if len(differences) == 0 {
+			drift.UpdatedPods = append(drift.UpdatedPods, pod.Name)
+		} else {
+			drift.OutdatedPods = append(drift.OutdatedPods, PodDrift{
+				Name:        pod.Name,
+				Differences: differences,
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// podTemplateDrift compares the containers of a running pod against the current pod template,
+// reporting image and environment differences per container.
+func podTemplateDrift(template *v1.PodSpec, podSpec *v1.PodSpec) []string {
+	differences := []string{}
+	podContainers := containerMap(podSpec.Containers)
+
+	for _, templateContainer := range template.Containers {
+		podContainer, found := podContainers[templateContainer.Name]
+		if !found {
+			differences = append(differences, fmt.Sprintf(
+				"container %s: missing from pod", templateContainer.Name))
+			continue
+		}
+		if podContainer.Image != templateContainer.Image {
+			differences = append(differences, fmt.Sprintf("container %s: image %s, template has %s",
+				templateContainer.Name, podContainer.Image, templateContainer.Image))
+		}
+		if envString(podContainer) != envString(templateContainer) {
+			differences = append(differences, fmt.Sprintf(
+				"container %s: environment differs from template", templateContainer.Name))
+		}
+	}
+
+	templateContainers := containerMap(template.Containers)
+	for _, podContainer := range podSpec.Containers {
+		if _, found := templateContainers[podContainer.Name]; !found {
+			differences = append(differences, fmt.Sprintf(
+				"container %s: not in template", podContainer.Name))
+		}
+	}
+
+	return differences
+}
+
+// containerMap indexes containers by their name.
+func containerMap(containers []v1.Container) map[string]v1.Container {
+	result := make(map[string]v1.Container, len(containers))
+	for _, container := range containers {
+		result[container.Name] = container
+	}
+	return result
+}
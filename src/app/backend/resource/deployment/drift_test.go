@@ -0,0 +1,73 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetDeploymentDrift(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	newPod := func(name, image string, phase v1.PodPhase) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns-1", Labels: labels},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "web", Image: image}},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+	fakeClient := fake.NewSimpleClientset(
+		&apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "dp-1", Namespace: "ns-1", Labels: labels},
+			Spec: apps.DeploymentSpec{
+				Selector: &metaV1.LabelSelector{MatchLabels: labels},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metaV1.ObjectMeta{Labels: labels},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{Name: "web", Image: "web:2"}},
+					},
+				},
+			},
+		},
+		newPod("pod-updated", "web:2", v1.PodRunning),
+		newPod("pod-outdated", "web:1", v1.PodRunning),
+		newPod("pod-pending", "web:1", v1.PodPending),
+	)
+
+	actual, err := GetDeploymentDrift(fakeClient, "ns-1", "dp-1")
+	if err != nil {
+		t.Fatalf("GetDeploymentDrift(client, ns-1, dp-1) returned error: %s", err)
+	}
+
+	expected := &DeploymentDrift{
+		UpdatedPods: []string{"pod-updated"},
+		OutdatedPods: []PodDrift{
+			{
+				Name:        "pod-outdated",
+				Differences: []string{"container web: image web:1, template has web:2"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetDeploymentDrift(client, ns-1, dp-1) == \n%+v, expected \n%+v", actual, expected)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// hibernatedReplicasAnnotation stores the replica count of a hibernated deployment, so waking
+// it can restore the original scale.
+const hibernatedReplicasAnnotation = "dashboard.kubernetes.io/hibernated-replicas"
+
+// HibernateDeployment records the current replica count of a deployment in an annotation and
+// scales it to zero. A deployment that is already at zero replicas is left untouched.
+func HibernateDeployment(client client.Interface, namespace, deploymentName string) error {
+	log.Printf("Hibernating %s deployment in %s namespace", deploymentName, namespace)
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if replicas == 0 {
+		return nil
+	}
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	deployment.Annotations[hibernatedReplicasAnnotation] = strconv.Itoa(int(replicas))
+	zero := int32(0)
+	deployment.Spec.Replicas = &zero
+
+	_, err = client.AppsV1beta2().Deployments(namespace).Update(deployment)
+	return err
+}
+
+// WakeDeployment restores the replica count a deployment had before it was hibernated and
+// removes the annotation that saved it.
+func WakeDeployment(client client.Interface, namespace, deploymentName string) error {
+	log.Printf("Waking %s deployment in %s namespace", deploymentName, namespace)
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	savedReplicas, found := deployment.Annotations[hibernatedReplicasAnnotation]
+	if !found {
+		return fmt.Errorf("Deployment %s has no saved replica count to restore.", deploymentName)
+	}
+	replicas, err := strconv.Atoi(savedReplicas)
+	if err != nil {
+		return err
+	}
+
+	restored := int32(replicas)
+	deployment.Spec.Replicas = &restored
+	delete(deployment.Annotations, hibernatedReplicasAnnotation)
+
+	_, err = client.AppsV1beta2().Deployments(namespace).Update(deployment)
+	return err
+}
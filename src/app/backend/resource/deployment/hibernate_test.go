@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestHibernateAndWakeDeployment(t *testing.T) {
+	replicas := int32(3)
+	fakeClient := fake.NewSimpleClientset(&apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "dp-1", Namespace: "ns-1"},
+		Spec:       apps.DeploymentSpec{Replicas: &replicas},
+	})
+
+	if err := HibernateDeployment(fakeClient, "ns-1", "dp-1"); err != nil {
+		t.Fatalf("HibernateDeployment(client, ns-1, dp-1) returned error: %s", err)
+	}
+
+	deployment, err := fakeClient.AppsV1beta2().Deployments("ns-1").Get("dp-1", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Cannot get deployment: %s", err)
+	}
+	if *deployment.Spec.Replicas != 0 {
+		t.Errorf("HibernateDeployment(client, ns-1, dp-1) left %d replicas, expected 0",
+			*deployment.Spec.Replicas)
+	}
+	if saved := deployment.Annotations[hibernatedReplicasAnnotation]; saved != "3" {
+		t.Errorf("HibernateDeployment(client, ns-1, dp-1) saved %q replicas, expected \"3\"", saved)
+	}
+
+	if err := WakeDeployment(fakeClient, "ns-1", "dp-1"); err != nil {
+		t.Fatalf("WakeDeployment(client, ns-1, dp-1) returned error: %s", err)
+	}
+
+	deployment, err = fakeClient.AppsV1beta2().Deployments("ns-1").Get("dp-1", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Cannot get deployment: %s", err)
+	}
+	if *deployment.Spec.Replicas != 3 {
+		t.Errorf("WakeDeployment(client, ns-1, dp-1) restored %d replicas, expected 3",
+			*deployment.Spec.Replicas)
+	}
+	if saved, found := deployment.Annotations[hibernatedReplicasAnnotation]; found {
+		t.Errorf("WakeDeployment(client, ns-1, dp-1) kept saved replicas annotation %q", saved)
+	}
+}
+
+func TestHibernateDeploymentAlreadyAtZero(t *testing.T) {
+	replicas := int32(0)
+	fakeClient := fake.NewSimpleClientset(&apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "dp-1", Namespace: "ns-1"},
+		Spec:       apps.DeploymentSpec{Replicas: &replicas},
+	})
+
+	if err := HibernateDeployment(fakeClient, "ns-1", "dp-1"); err != nil {
+		t.Fatalf("HibernateDeployment(client, ns-1, dp-1) returned error: %s", err)
+	}
+
+	deployment, err := fakeClient.AppsV1beta2().Deployments("ns-1").Get("dp-1", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Cannot get deployment: %s", err)
+	}
+	if _, found := deployment.Annotations[hibernatedReplicasAnnotation]; found {
+		t.Error("HibernateDeployment(client, ns-1, dp-1) saved replicas of a deployment already at zero")
+	}
+
+	if err := WakeDeployment(fakeClient, "ns-1", "dp-1"); err == nil {
+		t.Error("WakeDeployment(client, ns-1, dp-1) did not return error for a deployment without saved replicas")
+	}
+}
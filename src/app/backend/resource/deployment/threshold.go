@@ -0,0 +1,45 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"log"
+	"strconv"
+
+	apps "k8s.io/api/apps/v1beta2"
+)
+
+// minAvailableAnnotation holds the minimum number of available replicas a deployment should
+// have. Deployments below it are flagged as degraded in the detail view.
+const minAvailableAnnotation = "dashboard.kubernetes.io/min-available-replicas"
+
+// isBelowThreshold returns whether a deployment has fewer available replicas than the
+// min-available threshold set in its annotation. Deployments without the annotation (or with
+// an unparseable one) are never flagged.
+func isBelowThreshold(deployment *apps.Deployment) bool {
+	raw, found := deployment.Annotations[minAvailableAnnotation]
+	if !found {
+		return false
+	}
+
+	threshold, err := strconv.Atoi(raw)
+	if err != nil {
+		log.Printf("Invalid %s annotation on %s deployment: %q", minAvailableAnnotation,
+			deployment.Name, raw)
+		return false
+	}
+
+	return deployment.Status.AvailableReplicas < int32(threshold)
+}
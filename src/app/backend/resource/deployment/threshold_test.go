@@ -0,0 +1,46 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsBelowThreshold(t *testing.T) {
+	cases := []struct {
+		annotations       map[string]string
+		availableReplicas int32
+		expected          bool
+	}{
+		{map[string]string{minAvailableAnnotation: "3"}, 2, true},
+		{map[string]string{minAvailableAnnotation: "3"}, 3, false},
+		{map[string]string{minAvailableAnnotation: "not-a-number"}, 0, false},
+		{nil, 0, false},
+	}
+
+	for _, c := range cases {
+		deployment := &apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "dp-1", Annotations: c.annotations},
+			Status:     apps.DeploymentStatus{AvailableReplicas: c.availableReplicas},
+		}
+		if actual := isBelowThreshold(deployment); actual != c.expected {
+			t.Errorf("isBelowThreshold(%v available, annotations %v) == %t, expected %t",
+				c.availableReplicas, c.annotations, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// zoneLabel is the node label carrying the zone the node runs in.
+	zoneLabel = "topology.kubernetes.io/zone"
+
+	// betaZoneLabel is the older variant of the zone label, still set by most cloud providers.
+	betaZoneLabel = "failure-domain.beta.kubernetes.io/zone"
+
+	// unknownZone groups pods running on nodes that do not carry a zone label.
+	unknownZone = "unknown"
+)
+
+// NodeDistribution is the number of pods of a deployment running on a single node.
+type NodeDistribution struct {
+	// Name of the node.
+	Name string `json:"name"`
+
+	// Zone of the node, "unknown" when the node carries no zone label.
+	Zone string `json:"zone"`
+
+	// Number of pods of the deployment scheduled to the node.
+	PodCount int `json:"podCount"`
+}
+
+// ZoneDistribution is the number of pods of a deployment running in a single zone.
+type ZoneDistribution struct {
+	// Name of the zone.
+	Name string `json:"name"`
+
+	// Number of pods of the deployment scheduled to nodes of the zone.
+	PodCount int `json:"podCount"`
+}
+
+// DeploymentTopology describes how the pods of a deployment are spread across nodes and
+// zones, so users can verify that anti-affinity keeps them apart.
+type DeploymentTopology struct {
+	// Per-node pod counts, sorted by node name.
+	Nodes []NodeDistribution `json:"nodes"`
+
+	// Per-zone pod counts, sorted by zone name.
+	Zones []ZoneDistribution `json:"zones"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetDeploymentTopology returns the node and zone distribution of the pods of a deployment.
+// Pods not scheduled to any node yet are not counted.
+func GetDeploymentTopology(client client.Interface, namespace, deploymentName string) (
+	*DeploymentTopology, error) {
+	log.Printf("Getting topology of %s deployment in %s namespace", deploymentName, namespace)
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := metaV1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := client.CoreV1().Pods(namespace).List(metaV1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	nodeList, err := client.CoreV1().Nodes().List(api.ListEverything)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toDeploymentTopology(podList.Items, nodeList.Items, nonCriticalErrors), nil
+}
+
+func toDeploymentTopology(pods []v1.Pod, nodes []v1.Node,
+	nonCriticalErrors []error) *DeploymentTopology {
+	result := &DeploymentTopology{
+		Nodes:  make([]NodeDistribution, 0),
+		Zones:  make([]ZoneDistribution, 0),
+		Errors: nonCriticalErrors,
+	}
+
+	zoneByNode := make(map[string]string)
+	for _, node := range nodes {
+		zoneByNode[node.Name] = getNodeZone(&node)
+	}
+
+	podsByNode := make(map[string]int)
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		podsByNode[pod.Spec.NodeName]++
+	}
+
+	podsByZone := make(map[string]int)
+	for nodeName, podCount := range podsByNode {
+		zone, exists := zoneByNode[nodeName]
+		if !exists {
+			zone = unknownZone
+		}
+		podsByZone[zone] += podCount
+
+		result.Nodes = append(result.Nodes, NodeDistribution{
+			Name:     nodeName,
+			Zone:     zone,
+			PodCount: podCount,
+		})
+	}
+
+	for zone, podCount := range podsByZone {
+		result.Zones = append(result.Zones, ZoneDistribution{Name: zone, PodCount: podCount})
+	}
+
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		return result.Nodes[i].Name < result.Nodes[j].Name
+	})
+	sort.Slice(result.Zones, func(i, j int) bool {
+		return result.Zones[i].Name < result.Zones[j].Name
+	})
+	return result
+}
+
+// getNodeZone returns the zone of the node, preferring the stable zone label over its beta
+// predecessor. Nodes without either label report the unknown zone.
+func getNodeZone(node *v1.Node) string {
+	if zone, exists := node.Labels[zoneLabel]; exists {
+		return zone
+	}
+	if zone, exists := node.Labels[betaZoneLabel]; exists {
+		return zone
+	}
+	return unknownZone
+}
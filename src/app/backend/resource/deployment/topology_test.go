@@ -0,0 +1,68 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createScheduledPod(name, nodeName string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns-1",
+			Labels: map[string]string{"app": "test"}},
+		Spec: v1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func createZonedNode(name string, labels map[string]string) v1.Node {
+	return v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestToDeploymentTopology(t *testing.T) {
+	pods := []v1.Pod{
+		createScheduledPod("pod-1", "node-1"),
+		createScheduledPod("pod-2", "node-1"),
+		createScheduledPod("pod-3", "node-2"),
+		createScheduledPod("pod-4", ""),
+	}
+	nodes := []v1.Node{
+		createZonedNode("node-1", map[string]string{zoneLabel: "zone-a"}),
+		// The beta zone label is still honored when the stable one is absent.
+		createZonedNode("node-2", map[string]string{betaZoneLabel: "zone-b"}),
+	}
+
+	expected := &DeploymentTopology{
+		Nodes: []NodeDistribution{
+			{Name: "node-1", Zone: "zone-a", PodCount: 2},
+			{Name: "node-2", Zone: "zone-b", PodCount: 1},
+		},
+		Zones: []ZoneDistribution{
+			{Name: "zone-a", PodCount: 2},
+			{Name: "zone-b", PodCount: 1},
+		},
+		Errors: []error{},
+	}
+
+	actual := toDeploymentTopology(pods, nodes, []error{})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toDeploymentTopology(pods, nodes, errors) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
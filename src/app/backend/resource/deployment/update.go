@@ -0,0 +1,167 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/validation"
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// UpdateContainerImageSpec is a specification for updating the image of a single container in
+// a deployment pod template.
+type UpdateContainerImageSpec struct {
+	// Name of the container to update.
+	Container string `json:"container"`
+
+	// New image reference for the container.
+	Image string `json:"image"`
+}
+
+// UpdateContainerImage updates the image of the named container in the deployment pod
+// template, triggering a rolling update of the deployment.
+func UpdateContainerImage(client client.Interface, namespace, deploymentName string,
+	spec *UpdateContainerImageSpec) error {
+
+	log.Printf("Updating container %s of %s deployment in %s namespace to image %s",
+		spec.Container, deploymentName, namespace, spec.Image)
+
+	validity, err := validation.ValidateImageReference(&validation.ImageReferenceValiditySpec{
+		Reference: spec.Image,
+	})
+	if err != nil {
+		return err
+	}
+	if !validity.Valid {
+		return fmt.Errorf("Invalid image reference %s: %s", spec.Image, validity.Reason)
+	}
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	updated := false
+	for i := range deployment.Spec.Template.Spec.Containers {
+		if deployment.Spec.Template.Spec.Containers[i].Name == spec.Container {
+			deployment.Spec.Template.Spec.Containers[i].Image = spec.Image
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		return kdErrors.NewNotFound(fmt.Sprintf("Container %s was not found in %s deployment",
+			spec.Container, deploymentName))
+	}
+
+	_, err = client.AppsV1beta2().Deployments(namespace).Update(deployment)
+	return err
+}
+
+// envNamePattern matches valid C identifiers, which is what Kubernetes requires of
+// environment variable names.
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// UpdateEnvSpec is a specification for updating environment variables of a single container in
+// a deployment pod template.
+type UpdateEnvSpec struct {
+	// Name of the container to update.
+	Container string `json:"container"`
+
+	// Variables to add or overwrite.
+	Upsert []EnvironmentVariable `json:"upsert"`
+
+	// Names of variables to remove.
+	Remove []string `json:"remove"`
+}
+
+// UpdateEnvironmentVariables upserts and removes environment variables of the named container
+// in the deployment pod template, triggering a rolling update of the deployment.
+func UpdateEnvironmentVariables(client client.Interface, namespace, deploymentName string,
+	spec *UpdateEnvSpec) error {
+
+	log.Printf("Updating environment of container %s of %s deployment in %s namespace",
+		spec.Container, deploymentName, namespace)
+
+	for _, variable := range spec.Upsert {
+		if !envNamePattern.MatchString(variable.Name) {
+			return fmt.Errorf("Invalid environment variable name: %q", variable.Name)
+		}
+	}
+
+	deployment, err := client.AppsV1beta2().Deployments(namespace).Get(deploymentName,
+		metaV1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	containers := deployment.Spec.Template.Spec.Containers
+	updated := false
+	for i := range containers {
+		if containers[i].Name == spec.Container {
+			containers[i].Env = applyEnvUpdates(containers[i].Env, spec)
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		return kdErrors.NewNotFound(fmt.Sprintf("Container %s was not found in %s deployment",
+			spec.Container, deploymentName))
+	}
+
+	_, err = client.AppsV1beta2().Deployments(namespace).Update(deployment)
+	return err
+}
+
+// applyEnvUpdates overwrites or appends the upserted variables and drops the removed ones,
+// keeping the order of variables that stay.
+func applyEnvUpdates(env []api.EnvVar, spec *UpdateEnvSpec) []api.EnvVar {
+	removed := make(map[string]bool)
+	for _, name := range spec.Remove {
+		removed[name] = true
+	}
+
+	result := make([]api.EnvVar, 0, len(env))
+	upserted := make(map[string]bool)
+	for _, variable := range env {
+		if removed[variable.Name] {
+			continue
+		}
+		for _, upsert := range spec.Upsert {
+			if upsert.Name == variable.Name {
+				variable.Value = upsert.Value
+				variable.ValueFrom = nil
+				upserted[variable.Name] = true
+				break
+			}
+		}
+		result = append(result, variable)
+	}
+
+	for _, upsert := range spec.Upsert {
+		if !upserted[upsert.Name] && !removed[upsert.Name] {
+			result = append(result, api.EnvVar{Name: upsert.Name, Value: upsert.Value})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,159 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func testDeployment(namespace, name string) *apps.Deployment {
+	return &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: apps.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "app-image:1"},
+						{Name: "sidecar", Image: "sidecar-image:1"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUpdateContainerImage(t *testing.T) {
+	namespace, name := "default-ns", "test-deployment"
+	fakeClient := fake.NewSimpleClientset(testDeployment(namespace, name))
+
+	spec := &UpdateContainerImageSpec{Container: "app", Image: "app-image:2"}
+	err := UpdateContainerImage(fakeClient, namespace, name, spec)
+	if err != nil {
+		t.Fatalf("UpdateContainerImage(client, %s, %s, %+v) returned error: %s", namespace,
+			name, spec, err)
+	}
+
+	actions := fakeClient.Actions()
+	if len(actions) != 2 {
+		t.Fatalf("Unexpected actions: %v, expected 2 actions (get, update) got %d", actions,
+			len(actions))
+	}
+
+	updateAction, ok := actions[1].(core.UpdateActionImpl)
+	if !ok {
+		t.Fatalf("Unexpected action: %+v, expected update action", actions[1])
+	}
+
+	deployment := updateAction.GetObject().(*apps.Deployment)
+	containers := deployment.Spec.Template.Spec.Containers
+	if containers[0].Image != "app-image:2" {
+		t.Errorf("Unexpected image of container %s: %s, expected %s", containers[0].Name,
+			containers[0].Image, "app-image:2")
+	}
+	if containers[1].Image != "sidecar-image:1" {
+		t.Errorf("Unexpected image of container %s: %s, expected %s", containers[1].Name,
+			containers[1].Image, "sidecar-image:1")
+	}
+}
+
+func TestUpdateContainerImageUnknownContainer(t *testing.T) {
+	namespace, name := "default-ns", "test-deployment"
+	fakeClient := fake.NewSimpleClientset(testDeployment(namespace, name))
+
+	spec := &UpdateContainerImageSpec{Container: "unknown", Image: "app-image:2"}
+	err := UpdateContainerImage(fakeClient, namespace, name, spec)
+	if err == nil {
+		t.Fatalf("UpdateContainerImage(client, %s, %s, %+v) expected error for unknown container, got none",
+			namespace, name, spec)
+	}
+
+	for _, action := range fakeClient.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("Unexpected update action for unknown container: %+v", action)
+		}
+	}
+}
+
+func TestUpdateEnvironmentVariables(t *testing.T) {
+	namespace, name := "default-ns", "test-deployment"
+	deployment := testDeployment(namespace, name)
+	deployment.Spec.Template.Spec.Containers[0].Env = []v1.EnvVar{
+		{Name: "KEEP", Value: "1"}, {Name: "OLD", Value: "x"}, {Name: "DROP", Value: "y"},
+	}
+	fakeClient := fake.NewSimpleClientset(deployment)
+
+	spec := &UpdateEnvSpec{
+		Container: "app",
+		Upsert:    []EnvironmentVariable{{Name: "OLD", Value: "z"}, {Name: "NEW", Value: "n"}},
+		Remove:    []string{"DROP"},
+	}
+	err := UpdateEnvironmentVariables(fakeClient, namespace, name, spec)
+	if err != nil {
+		t.Fatalf("UpdateEnvironmentVariables(client, %s, %s, %+v) returned error: %s",
+			namespace, name, spec, err)
+	}
+
+	actions := fakeClient.Actions()
+	if len(actions) != 2 {
+		t.Fatalf("Unexpected actions: %v, expected 2 actions (get, update) got %d", actions,
+			len(actions))
+	}
+
+	updateAction, ok := actions[1].(core.UpdateActionImpl)
+	if !ok {
+		t.Fatalf("Unexpected action: %+v, expected update action", actions[1])
+	}
+
+	updated := updateAction.GetObject().(*apps.Deployment)
+	containers := updated.Spec.Template.Spec.Containers
+	expected := []v1.EnvVar{{Name: "KEEP", Value: "1"}, {Name: "OLD", Value: "z"},
+		{Name: "NEW", Value: "n"}}
+	if !reflect.DeepEqual(containers[0].Env, expected) {
+		t.Errorf("Unexpected environment of container %s: %+v, expected %+v",
+			containers[0].Name, containers[0].Env, expected)
+	}
+	if len(containers[1].Env) != 0 {
+		t.Errorf("Unexpected environment of container %s: %+v, expected no variables",
+			containers[1].Name, containers[1].Env)
+	}
+}
+
+func TestUpdateEnvironmentVariablesInvalidName(t *testing.T) {
+	namespace, name := "default-ns", "test-deployment"
+	fakeClient := fake.NewSimpleClientset(testDeployment(namespace, name))
+
+	spec := &UpdateEnvSpec{
+		Container: "app",
+		Upsert:    []EnvironmentVariable{{Name: "1BAD", Value: "x"}},
+	}
+	err := UpdateEnvironmentVariables(fakeClient, namespace, name, spec)
+	if err == nil {
+		t.Fatalf("UpdateEnvironmentVariables(client, %s, %s, %+v) expected error for invalid "+
+			"variable name, got none", namespace, name, spec)
+	}
+
+	for _, action := range fakeClient.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("Unexpected update action for invalid variable name: %+v", action)
+		}
+	}
+}
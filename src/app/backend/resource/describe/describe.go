@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package describe
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	clientapi "github.com/kubernetes/dashboard/src/app/backend/client/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// Description is a "kubectl describe" style aggregate view of a single resource: the raw
+// object, the events involving it and, for controllers, the pods their selector targets,
+// assembled server-side in one call.
+type Description struct {
+	// Raw object as returned by the apiserver.
+	Object runtime.Object `json:"object"`
+
+	// Events involving the object.
+	Events []v1.Event `json:"events"`
+
+	// Pods targeted by the controller selector, empty for resources without one.
+	Pods []v1.Pod `json:"pods"`
+}
+
+// objectIdentity is the subset of a raw object needed to correlate its events and pods.
+type objectIdentity struct {
+	Metadata struct {
+		UID types.UID `json:"uid"`
+	} `json:"metadata"`
+	Spec struct {
+		Selector json.RawMessage `json:"selector"`
+	} `json:"spec"`
+}
+
+// GetDescription returns the describe view of the resource of the given kind in the given
+// namespace with the given name. The object is fetched through the verber, so every kind the
+// raw endpoints support is covered, and events come from the shared per-namespace cache.
+func GetDescription(verber clientapi.ResourceVerber, client k8sClient.Interface,
+	eventCache *event.Cache, kind, namespace, name string) (*Description, error) {
+
+	log.Printf("Describing %s %s in %s namespace", kind, name, namespace)
+
+	object, err := verber.Get(kind, true, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	description := &Description{
+		Object: object,
+		Events: make([]v1.Event, 0),
+		Pods:   make([]v1.Pod, 0),
+	}
+
+	identity, err := parseObjectIdentity(object)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := eventCache.Events(identity.Metadata.UID)
+	if err != nil {
+		return nil, err
+	}
+	description.Events = append(description.Events, events...)
+
+	selector := parseSelector(identity.Spec.Selector)
+	if len(selector) > 0 {
+		pods, err := client.CoreV1().Pods(namespace).List(api.ListEverything)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods.Items {
+			if api.IsSelectorMatching(selector, pod.Labels) {
+				description.Pods = append(description.Pods, pod)
+			}
+		}
+	}
+
+	return description, nil
+}
+
+// parseObjectIdentity extracts the UID and selector of the raw object returned by the verber.
+func parseObjectIdentity(object runtime.Object) (*objectIdentity, error) {
+	unknown, ok := object.(*runtime.Unknown)
+	if !ok {
+		return &objectIdentity{}, nil
+	}
+
+	identity := &objectIdentity{}
+	if err := json.Unmarshal(unknown.Raw, identity); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// parseSelector reads a controller selector that is either a plain label map (replication
+// controllers, services) or a LabelSelector (deployments, replica sets, daemon sets, stateful
+// sets, jobs). Match expressions are not resolved here, only match labels.
+func parseSelector(raw json.RawMessage) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	labelSelector := metaV1.LabelSelector{}
+	if err := json.Unmarshal(raw, &labelSelector); err == nil && len(labelSelector.MatchLabels) > 0 {
+		return labelSelector.MatchLabels
+	}
+
+	plainSelector := map[string]string{}
+	if err := json.Unmarshal(raw, &plainSelector); err == nil {
+		return plainSelector
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeVerber returns a fixed raw object for every Get call.
+type fakeVerber struct {
+	object *runtime.Unknown
+}
+
+func (verber *fakeVerber) Get(kind string, namespaceSet bool, namespace string,
+	name string) (runtime.Object, error) {
+	return verber.object, nil
+}
+
+func (verber *fakeVerber) Put(kind string, namespaceSet bool, namespace string, name string,
+	object *runtime.Unknown) error {
+	return nil
+}
+
+func (verber *fakeVerber) Delete(kind string, namespaceSet bool, namespace string,
+	name string) error {
+	return nil
+}
+
+func (verber *fakeVerber) Apply(kind string, namespaceSet bool, namespace string, name string,
+	fieldManager string, force bool, patch []byte) error {
+	return nil
+}
+
+func (verber *fakeVerber) Patch(kind string, namespaceSet bool, namespace string, name string,
+	patch []byte) error {
+	return nil
+}
+
+func (verber *fakeVerber) PatchJSON(kind string, namespaceSet bool, namespace string,
+	name string, patch []byte) error {
+	return nil
+}
+
+func (verber *fakeVerber) Count(kind string, namespaceSet bool, namespace string) (int, error) {
+	return 0, nil
+}
+
+func TestGetDescription(t *testing.T) {
+	verber := &fakeVerber{object: &runtime.Unknown{Raw: []byte(`{
+		"kind": "Deployment",
+		"metadata": {"name": "dp-1", "namespace": "ns-1", "uid": "uid-1"},
+		"spec": {"selector": {"matchLabels": {"app": "test"}}}
+	}`)}}
+	fakeClient := fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{
+			Name: "pod-1", Namespace: "ns-1", Labels: map[string]string{"app": "test"},
+		}},
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{
+			Name: "pod-2", Namespace: "ns-1", Labels: map[string]string{"app": "other"},
+		}},
+		&v1.Event{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-1"},
+			Message:        "Scaled up replica set",
+		})
+	eventCache := event.NewCache(fakeClient, "ns-1")
+
+	description, err := GetDescription(verber, fakeClient, eventCache, "deployment", "ns-1", "dp-1")
+	if err != nil {
+		t.Fatalf("GetDescription returned error: %v", err)
+	}
+
+	if description.Object != runtime.Object(verber.object) {
+		t.Errorf("GetDescription did not return the verber object")
+	}
+	if len(description.Events) != 1 || description.Events[0].Message != "Scaled up replica set" {
+		t.Errorf("GetDescription returned events %#v, expected the deployment event", description.Events)
+	}
+	if len(description.Pods) != 1 || description.Pods[0].Name != "pod-1" {
+		t.Errorf("GetDescription returned pods %#v, expected only the pod matching the selector",
+			description.Pods)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"sync"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// Cache serves the events of a single namespace keyed by the UID of the object they involve.
+// Detail views composing several resources share one cache, so the namespace event list is
+// fetched from the apiserver only once instead of once per resource.
+type Cache struct {
+	client    client.Interface
+	namespace string
+
+	once   sync.Once
+	events map[types.UID][]v1.Event
+	err    error
+}
+
+// NewCache creates an event cache for the given namespace. No events are fetched until the
+// first call to Events.
+func NewCache(client client.Interface, namespace string) *Cache {
+	return &Cache{client: client, namespace: namespace}
+}
+
+// Events returns the events involving the object with the given UID. The namespace event list
+// is fetched on first use and reused by subsequent calls, which is safe to do concurrently.
+func (cache *Cache) Events(uid types.UID) ([]v1.Event, error) {
+	cache.once.Do(cache.fetch)
+	if cache.err != nil {
+		return nil, cache.err
+	}
+	return cache.events[uid], nil
+}
+
+// fetch populates the cache from a single namespace event list.
+func (cache *Cache) fetch() {
+	eventList, err := cache.client.CoreV1().Events(cache.namespace).List(api.ListEverything)
+	if err != nil {
+		cache.err = err
+		return
+	}
+
+	cache.events = make(map[types.UID][]v1.Event)
+	for _, event := range FillEventsType(eventList.Items) {
+		uid := event.InvolvedObject.UID
+		cache.events[uid] = append(cache.events[uid], event)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEventCacheServesMultipleObjectsFromOneList(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.EventList{Items: []v1.Event{
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-1"},
+			Message:        "event of first object",
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-2", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "uid-2"},
+			Message:        "event of second object",
+		},
+	}})
+	cache := NewCache(fakeClient, "ns-1")
+
+	for uid, expectedMessage := range map[string]string{
+		"uid-1": "event of first object",
+		"uid-2": "event of second object",
+	} {
+		events, err := cache.Events(types.UID(uid))
+		if err != nil {
+			t.Fatalf("Events(%s) returned error: %v", uid, err)
+		}
+		if len(events) != 1 || events[0].Message != expectedMessage {
+			t.Errorf("Events(%s) == %#v, expected single event with message %#v", uid, events,
+				expectedMessage)
+		}
+	}
+
+	listCalls := 0
+	for _, action := range fakeClient.Actions() {
+		if action.Matches("list", "events") {
+			listCalls++
+		}
+	}
+	if listCalls != 1 {
+		t.Errorf("Expected one event list call to serve all objects but got %d", listCalls)
+	}
+}
+
+func TestEventCacheReturnsNoEventsForUnknownObject(t *testing.T) {
+	cache := NewCache(fake.NewSimpleClientset(), "ns-1")
+
+	events, err := cache.Events("uid-1")
+	if err != nil {
+		t.Fatalf("Events(uid-1) returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Events(uid-1) == %#v, expected no events", events)
+	}
+}
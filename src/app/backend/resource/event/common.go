@@ -15,6 +15,8 @@
 package event
 
 import (
+	"strings"
+
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
@@ -62,6 +64,60 @@ func GetEvents(client client.Interface, namespace, resourceName string) ([]v1.Ev
 	return FillEventsType(eventList.Items), nil
 }
 
+// involvedObjectKinds maps dashboard resource kind names to the kind names used in the
+// involvedObject reference of events.
+var involvedObjectKinds = map[string]string{
+	api.ResourceKindDaemonSet:             "DaemonSet",
+	api.ResourceKindDeployment:            "Deployment",
+	api.ResourceKindJob:                   "Job",
+	api.ResourceKindCronJob:               "CronJob",
+	api.ResourceKindPod:                   "Pod",
+	api.ResourceKindReplicaSet:            "ReplicaSet",
+	api.ResourceKindReplicationController: "ReplicationController",
+	api.ResourceKindService:               "Service",
+	api.ResourceKindStatefulSet:           "StatefulSet",
+	api.ResourceKindIngress:               "Ingress",
+	api.ResourceKindPersistentVolumeClaim: "PersistentVolumeClaim",
+	api.ResourceKindConfigMap:             "ConfigMap",
+	api.ResourceKindSecret:                "Secret",
+}
+
+// GetInvolvedObjectEvents gets events whose involved object matches the given kind and name.
+// The kind accepts both dashboard resource kind names (e.g. "replicaset") and the kind names
+// used by events themselves (e.g. "ReplicaSet").
+func GetInvolvedObjectEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+	namespace, kind, name string) (*common.EventList, error) {
+
+	if mapped, found := involvedObjectKinds[strings.ToLower(kind)]; found {
+		kind = mapped
+	}
+
+	fieldSelector, err := fields.ParseSelector(
+		"involvedObject.kind=" + kind + ",involvedObject.name=" + name)
+	if err != nil {
+		return EmptyEventList, err
+	}
+
+	channels := &common.ResourceChannels{
+		EventList: common.GetEventListChannelWithOptions(
+			client,
+			common.NewSameNamespaceQuery(namespace),
+			metaV1.ListOptions{
+				LabelSelector: labels.Everything().String(),
+				FieldSelector: fieldSelector.String(),
+			},
+			1),
+	}
+
+	eventList := <-channels.EventList.List
+	if err := <-channels.EventList.Error; err != nil {
+		return EmptyEventList, err
+	}
+
+	events := CreateEventList(FillEventsType(eventList.Items), dsQuery)
+	return &events, nil
+}
+
 // GetPodsEvents gets events targeting given list of pods.
 func GetPodsEvents(client client.Interface, namespace string, pods []v1.Pod) (
 	[]v1.Event, error) {
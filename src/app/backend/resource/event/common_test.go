@@ -25,6 +25,7 @@ import (
 	"k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
 )
 
 func TestGetEvents(t *testing.T) {
@@ -178,3 +179,30 @@ func TestGetResourceEvents(t *testing.T) {
 		}
 	}
 }
+
+func TestGetInvolvedObjectEvents(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.EventList{})
+
+	_, err := GetInvolvedObjectEvents(fakeClient, dataselect.NoDataSelect, "ns-1", "replicaset",
+		"rs-1")
+	if err != nil {
+		t.Errorf("GetInvolvedObjectEvents(client, dsQuery, ns-1, replicaset, rs-1) returned error: %s",
+			err)
+	}
+
+	actions := fakeClient.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("Unexpected actions: %v, expected 1 action got %d", actions, len(actions))
+	}
+
+	listAction, ok := actions[0].(core.ListActionImpl)
+	if !ok {
+		t.Fatalf("Unexpected action: %+v, expected list action", actions[0])
+	}
+
+	expectedSelector := "involvedObject.kind=ReplicaSet,involvedObject.name=rs-1"
+	actualSelector := listAction.GetListRestrictions().Fields.String()
+	if actualSelector != expectedSelector {
+		t.Errorf("Unexpected field selector: %s, expected %s", actualSelector, expectedSelector)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// WatchEvents starts a watch on events, cluster-wide when the namespace is empty or scoped
+// to a single namespace otherwise.
+func WatchEvents(client client.Interface, namespace string) (watch.Interface, error) {
+	return client.CoreV1().Events(namespace).Watch(api.ListEverything)
+}
+
+// StreamEvents consumes the given event watch and calls push for every event translated to
+// the presentation shape. When eventType is non-empty only events of that type are pushed,
+// so clients can subscribe to warnings only. It returns once the watch ends or push returns
+// an error (i.e. the client disconnected). The watch is always stopped before returning.
+func StreamEvents(watcher watch.Interface, eventType string, push func(common.Event) error) error {
+	defer watcher.Stop()
+
+	for watchEvent := range watcher.ResultChan() {
+		if watchEvent.Type == watch.Deleted {
+			continue
+		}
+		rawEvent, ok := watchEvent.Object.(*v1.Event)
+		if !ok {
+			continue
+		}
+
+		filledEvent := FillEventsType([]v1.Event{*rawEvent})[0]
+		if eventType != "" && filledEvent.Type != eventType {
+			continue
+		}
+
+		if err := push(ToEvent(filledEvent)); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func createWatchedEvent(name, eventType, reason string) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns-1"},
+		Type:       eventType,
+		Reason:     reason,
+	}
+}
+
+func TestStreamEventsFiltersByType(t *testing.T) {
+	watcher := watch.NewFake()
+
+	go func() {
+		watcher.Add(createWatchedEvent("ev-1", v1.EventTypeWarning, "FailedScheduling"))
+		watcher.Add(createWatchedEvent("ev-2", v1.EventTypeNormal, "Scheduled"))
+		// Events with an empty type get it filled in from the reason before filtering.
+		watcher.Add(createWatchedEvent("ev-3", "", "FailedMount"))
+		watcher.Stop()
+	}()
+
+	streamed := make([]string, 0)
+	err := StreamEvents(watcher, v1.EventTypeWarning, func(event common.Event) error {
+		streamed = append(streamed, event.ObjectMeta.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamEvents(watcher, Warning, push) returned error: %s", err)
+	}
+
+	expected := []string{"ev-1", "ev-3"}
+	if !reflect.DeepEqual(streamed, expected) {
+		t.Errorf("StreamEvents(watcher, Warning, push) streamed %#v, expected %#v", streamed,
+			expected)
+	}
+}
@@ -15,10 +15,12 @@
 package horizontalpodautoscaler
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	autoscaling "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
 )
@@ -41,19 +43,56 @@ type HorizontalPodAutoscalerDetail struct {
 	DesiredReplicas int32 `json:"desiredReplicas"`
 
 	LastScaleTime *v1.Time `json:"lastScaleTime"`
+
+	// Metrics the autoscaler acts on, as specified in autoscaling/v2beta1. Empty for
+	// autoscalers served only by autoscaling/v1, whose single CPU target is surfaced through
+	// the CPU utilization fields above.
+	Metrics []HorizontalPodAutoscalerMetric `json:"metrics,omitempty"`
+
+	// ScalingHistory lists recent scaling decisions of the autoscaler in chronological order,
+	// derived from its SuccessfulRescale events.
+	ScalingHistory []ScalingEvent `json:"scalingHistory"`
+}
+
+// HorizontalPodAutoscalerMetric is a single metric target of an autoscaling/v2beta1 horizontal
+// pod autoscaler together with its last observed value.
+type HorizontalPodAutoscalerMetric struct {
+	// Type of the metric source, i.e., "Resource", "Pods" or "Object".
+	Type string `json:"type"`
+
+	// Name of the resource or custom metric.
+	Name string `json:"name"`
+
+	// Target value of the metric that the autoscaler maintains.
+	Target string `json:"target"`
+
+	// Last value of the metric observed by the autoscaler, empty when not reported yet.
+	Current string `json:"current"`
 }
 
-// GetHorizontalPodAutoscalerDetail returns detailed information about a horizontal pod autoscaler
+// GetHorizontalPodAutoscalerDetail returns detailed information about a horizontal pod autoscaler.
+// The autoscaler is read through autoscaling/v2beta1 so that all of its metric targets are
+// surfaced, with a fallback to autoscaling/v1 when the v2beta1 API is not served.
 func GetHorizontalPodAutoscalerDetail(client client.Interface, namespace string, name string) (*HorizontalPodAutoscalerDetail, error) {
 	log.Printf("Getting details of %s horizontal pod autoscaler", name)
 
+	rawV2HorizontalPodAutoscaler, err := client.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace).Get(name, v1.GetOptions{})
+	if err == nil {
+		detail := getHorizontalPodAutoscalerV2Detail(rawV2HorizontalPodAutoscaler)
+		detail.ScalingHistory = getScalingHistory(client, namespace, name)
+		return detail, nil
+	}
+	log.Printf("Falling back to autoscaling/v1 for %s horizontal pod autoscaler: %s", name, err)
+
 	rawHorizontalPodAutoscaler, err := client.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, v1.GetOptions{})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return getHorizontalPodAutoscalerDetail(rawHorizontalPodAutoscaler), nil
+	detail := getHorizontalPodAutoscalerDetail(rawHorizontalPodAutoscaler)
+	detail.ScalingHistory = getScalingHistory(client, namespace, name)
+	return detail, nil
 }
 
 func getHorizontalPodAutoscalerDetail(horizontalPodAutoscaler *autoscaling.HorizontalPodAutoscaler) *HorizontalPodAutoscalerDetail {
@@ -78,3 +117,112 @@ func getHorizontalPodAutoscalerDetail(horizontalPodAutoscaler *autoscaling.Horiz
 		LastScaleTime: horizontalPodAutoscaler.Status.LastScaleTime,
 	}
 }
+
+func getHorizontalPodAutoscalerV2Detail(horizontalPodAutoscaler *autoscalingv2.HorizontalPodAutoscaler) *HorizontalPodAutoscalerDetail {
+	detail := &HorizontalPodAutoscalerDetail{
+		ObjectMeta: api.NewObjectMeta(horizontalPodAutoscaler.ObjectMeta),
+		TypeMeta:   api.NewTypeMeta(api.ResourceKindHorizontalPodAutoscaler),
+
+		ScaleTargetRef: ScaleTargetRef{
+			Kind: horizontalPodAutoscaler.Spec.ScaleTargetRef.Kind,
+			Name: horizontalPodAutoscaler.Spec.ScaleTargetRef.Name,
+		},
+
+		MinReplicas: horizontalPodAutoscaler.Spec.MinReplicas,
+		MaxReplicas: horizontalPodAutoscaler.Spec.MaxReplicas,
+
+		CurrentReplicas: horizontalPodAutoscaler.Status.CurrentReplicas,
+		DesiredReplicas: horizontalPodAutoscaler.Status.DesiredReplicas,
+
+		LastScaleTime: horizontalPodAutoscaler.Status.LastScaleTime,
+
+		Metrics: make([]HorizontalPodAutoscalerMetric, 0),
+	}
+
+	for _, metricSpec := range horizontalPodAutoscaler.Spec.Metrics {
+		metric := HorizontalPodAutoscalerMetric{
+			Type:    string(metricSpec.Type),
+			Name:    metricSpecName(metricSpec),
+			Target:  metricSpecTarget(metricSpec),
+			Current: currentMetricValue(horizontalPodAutoscaler.Status.CurrentMetrics, metricSpec),
+		}
+		detail.Metrics = append(detail.Metrics, metric)
+
+		// Keep the v1 CPU utilization fields populated for the CPU resource metric, so that
+		// existing clients do not care which API version the autoscaler was read through.
+		if metricSpec.Type == autoscalingv2.ResourceMetricSourceType &&
+			metricSpec.Resource.Name == "cpu" {
+			detail.TargetCPUUtilizationPercentage = metricSpec.Resource.TargetAverageUtilization
+			for _, currentMetric := range horizontalPodAutoscaler.Status.CurrentMetrics {
+				if currentMetric.Type == autoscalingv2.ResourceMetricSourceType &&
+					currentMetric.Resource.Name == "cpu" {
+					detail.CurrentCPUUtilizationPercentage = currentMetric.Resource.CurrentAverageUtilization
+				}
+			}
+		}
+	}
+
+	return detail
+}
+
+// metricSpecName returns the name of the resource or custom metric targeted by the metric spec.
+func metricSpecName(metricSpec autoscalingv2.MetricSpec) string {
+	switch metricSpec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		return string(metricSpec.Resource.Name)
+	case autoscalingv2.PodsMetricSourceType:
+		return metricSpec.Pods.MetricName
+	case autoscalingv2.ObjectMetricSourceType:
+		return metricSpec.Object.MetricName
+	}
+	return ""
+}
+
+// metricSpecTarget renders the target value of the metric spec in a human readable form.
+func metricSpecTarget(metricSpec autoscalingv2.MetricSpec) string {
+	switch metricSpec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if metricSpec.Resource.TargetAverageUtilization != nil {
+			return fmt.Sprintf("%d%%", *metricSpec.Resource.TargetAverageUtilization)
+		}
+		if metricSpec.Resource.TargetAverageValue != nil {
+			return metricSpec.Resource.TargetAverageValue.String()
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		return metricSpec.Pods.TargetAverageValue.String()
+	case autoscalingv2.ObjectMetricSourceType:
+		return metricSpec.Object.TargetValue.String()
+	}
+	return ""
+}
+
+// currentMetricValue renders the last observed value of the metric described by the metric spec,
+// or an empty string when the autoscaler has not reported it yet.
+func currentMetricValue(currentMetrics []autoscalingv2.MetricStatus, metricSpec autoscalingv2.MetricSpec) string {
+	for _, currentMetric := range currentMetrics {
+		if currentMetric.Type != metricSpec.Type {
+			continue
+		}
+		switch metricSpec.Type {
+		case autoscalingv2.ResourceMetricSourceType:
+			if currentMetric.Resource.Name != metricSpec.Resource.Name {
+				continue
+			}
+			if currentMetric.Resource.CurrentAverageUtilization != nil {
+				return fmt.Sprintf("%d%%", *currentMetric.Resource.CurrentAverageUtilization)
+			}
+			return currentMetric.Resource.CurrentAverageValue.String()
+		case autoscalingv2.PodsMetricSourceType:
+			if currentMetric.Pods.MetricName != metricSpec.Pods.MetricName {
+				continue
+			}
+			return currentMetric.Pods.CurrentAverageValue.String()
+		case autoscalingv2.ObjectMetricSourceType:
+			if currentMetric.Object.MetricName != metricSpec.Object.MetricName {
+				continue
+			}
+			return currentMetric.Object.CurrentValue.String()
+		}
+	}
+	return ""
+}
@@ -20,6 +20,8 @@ import (
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	autoscaling "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -35,7 +37,9 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 	}{
 		{
 			"test-ns", "test-name",
-			[]string{"get"},
+			// The v2beta1 get fails for an autoscaler that only exists in v1, triggering the
+			// fallback get through the v1 API. The list reads events for the scaling history.
+			[]string{"get", "get", "list"},
 			&autoscaling.HorizontalPodAutoscaler{
 				ObjectMeta: metaV1.ObjectMeta{Name: "test-name", Namespace: "test-ns"},
 				Spec: autoscaling.HorizontalPodAutoscalerSpec{
@@ -60,6 +64,7 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 				MaxReplicas:     3,
 				CurrentReplicas: 1,
 				DesiredReplicas: 2,
+				ScalingHistory:  []ScalingEvent{},
 			},
 		},
 	}
@@ -89,3 +94,77 @@ func TestGetHorizontalPodAutoscalerDetail(t *testing.T) {
 		}
 	}
 }
+
+func TestGetHorizontalPodAutoscalerV2Detail(t *testing.T) {
+	targetMemory := resource.MustParse("512Mi")
+	currentMemory := resource.MustParse("256Mi")
+	targetQps := resource.MustParse("100")
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-name", Namespace: "test-ns"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind: "test-kind",
+				Name: "test-name2",
+			},
+			MaxReplicas: 3,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:               "memory",
+						TargetAverageValue: &targetMemory,
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						MetricName:         "queries-per-second",
+						TargetAverageValue: targetQps,
+					},
+				},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 1,
+			DesiredReplicas: 2,
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:                "memory",
+						CurrentAverageValue: currentMemory,
+					},
+				},
+			},
+		},
+	}
+	expected := &HorizontalPodAutoscalerDetail{
+		ObjectMeta: api.ObjectMeta{Name: "test-name", Namespace: "test-ns"},
+		TypeMeta:   api.TypeMeta{Kind: api.ResourceKindHorizontalPodAutoscaler},
+		ScaleTargetRef: ScaleTargetRef{
+			Kind: "test-kind",
+			Name: "test-name2",
+		},
+		MaxReplicas:     3,
+		CurrentReplicas: 1,
+		DesiredReplicas: 2,
+		Metrics: []HorizontalPodAutoscalerMetric{
+			{Type: "Resource", Name: "memory", Target: "512Mi", Current: "256Mi"},
+			{Type: "Pods", Name: "queries-per-second", Target: "100", Current: ""},
+		},
+		ScalingHistory: []ScalingEvent{},
+	}
+
+	fakeClient := fake.NewSimpleClientset(hpa)
+
+	actual, err := GetHorizontalPodAutoscalerDetail(fakeClient, "test-ns", "test-name")
+	if err != nil {
+		t.Fatalf("GetHorizontalPodAutoscalerDetail(client, test-ns, test-name) returned error: %s",
+			err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetHorizontalPodAutoscalerDetail(client, test-ns, test-name) == \ngot: %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// successfulRescaleReason is the reason the horizontal pod autoscaler controller records on
+// events emitted for every applied scaling decision.
+const successfulRescaleReason = "SuccessfulRescale"
+
+// ScalingEvent is a single scaling decision of a horizontal pod autoscaler, derived from one
+// of its SuccessfulRescale events.
+type ScalingEvent struct {
+	// Message of the rescale event, describing the new size and the reason for it.
+	Message string `json:"message"`
+
+	// Time of the last occurrence of the rescale.
+	Timestamp metaV1.Time `json:"timestamp"`
+}
+
+// getScalingHistory returns the scaling decisions recorded for the given horizontal pod
+// autoscaler, oldest first. Events expire, so the history only explains recent rescales.
+// Failure to read events back does not fail the detail view, it only logs.
+func getScalingHistory(client client.Interface, namespace, name string) []ScalingEvent {
+	result := make([]ScalingEvent, 0)
+
+	events, err := event.GetEvents(client, namespace, name)
+	if err != nil {
+		log.Printf("Could not get scaling history of %s horizontal pod autoscaler: %s", name, err)
+		return result
+	}
+
+	for _, e := range events {
+		if e.Reason != successfulRescaleReason {
+			continue
+		}
+		result = append(result, ScalingEvent{Message: e.Message, Timestamp: e.LastTimestamp})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp.Before(&result[j].Timestamp)
+	})
+	return result
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetScalingHistory(t *testing.T) {
+	firstRescale := metaV1.NewTime(time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC))
+	secondRescale := metaV1.NewTime(time.Date(2018, 1, 1, 11, 0, 0, 0, time.UTC))
+
+	// Events are stored newest first to verify that the history gets sorted chronologically.
+	events := []*v1.Event{
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-2", Namespace: "test-ns"},
+			InvolvedObject: v1.ObjectReference{Name: "test-hpa", Namespace: "test-ns"},
+			Reason:         successfulRescaleReason,
+			Message:        "New size: 4; reason: cpu resource utilization above target",
+			LastTimestamp:  secondRescale,
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-1", Namespace: "test-ns"},
+			InvolvedObject: v1.ObjectReference{Name: "test-hpa", Namespace: "test-ns"},
+			Reason:         successfulRescaleReason,
+			Message:        "New size: 2; reason: cpu resource utilization above target",
+			LastTimestamp:  firstRescale,
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-3", Namespace: "test-ns"},
+			InvolvedObject: v1.ObjectReference{Name: "test-hpa", Namespace: "test-ns"},
+			Reason:         "FailedGetResourceMetric",
+			Message:        "missing request for cpu",
+			LastTimestamp:  secondRescale,
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(events[0], events[1], events[2])
+
+	expected := []ScalingEvent{
+		{Message: "New size: 2; reason: cpu resource utilization above target", Timestamp: firstRescale},
+		{Message: "New size: 4; reason: cpu resource utilization above target", Timestamp: secondRescale},
+	}
+
+	actual := getScalingHistory(fakeClient, "test-ns", "test-hpa")
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getScalingHistory(client, test-ns, test-hpa) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -19,6 +19,19 @@ import (
 	extensions "k8s.io/api/extensions/v1beta1"
 )
 
+// ingressClassAnnotation selects the ingress controller handling an ingress. The class became
+// a spec field in the networking.k8s.io API - extensions/v1beta1 only knows the annotation.
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// getIngressClassName returns the class of given ingress normalized to the shape of the
+// networking.k8s.io ingressClassName field, or nil when no class is set.
+func getIngressClassName(ingress *extensions.Ingress) *string {
+	if class, exists := ingress.Annotations[ingressClassAnnotation]; exists {
+		return &class
+	}
+	return nil
+}
+
 // The code below allows to perform complex data section on []extensions.Ingress
 
 type IngressCell extensions.Ingress
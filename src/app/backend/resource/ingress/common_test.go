@@ -0,0 +1,41 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"testing"
+
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetIngressClassName(t *testing.T) {
+	annotated := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name: "ing-1", Namespace: "ns-1",
+			Annotations: map[string]string{ingressClassAnnotation: "nginx"},
+		},
+	}
+	plain := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{Name: "ing-2", Namespace: "ns-1"},
+	}
+
+	if className := getIngressClassName(annotated); className == nil || *className != "nginx" {
+		t.Errorf("Expected ingress class name nginx but got %v", className)
+	}
+	if className := getIngressClassName(plain); className != nil {
+		t.Errorf("Expected no ingress class name but got %v", *className)
+	}
+}
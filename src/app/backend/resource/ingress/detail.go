@@ -18,6 +18,7 @@ import (
 	"log"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
 	extensions "k8s.io/api/extensions/v1beta1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	client "k8s.io/client-go/kubernetes"
@@ -36,10 +37,41 @@ type IngressDetail struct {
 	// Status is the current state of the Ingress.
 	Status extensions.IngressStatus `json:"status"`
 
+	// Class of this ingress, normalized to the networking.k8s.io ingressClassName shape.
+	IngressClassName *string `json:"ingressClassName"`
+
+	// BackendHealth summarizes whether the backends referenced by the ingress resolve to
+	// existing services with ready endpoints.
+	BackendHealth []BackendHealth `json:"backendHealth"`
+
+	// TLSCertificates describes the certificates referenced by the ingress TLS entries.
+	TLSCertificates []TLSCertificateInfo `json:"tlsCertificates"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
 
+// BackendHealth is a summary of a single ingress backend resolution.
+type BackendHealth struct {
+	// Host of the rule the backend belongs to, empty for the default backend.
+	Host string `json:"host"`
+
+	// Path of the rule the backend belongs to, empty for the default backend.
+	Path string `json:"path"`
+
+	// Name of the referenced service.
+	ServiceName string `json:"serviceName"`
+
+	// Port of the referenced service.
+	ServicePort string `json:"servicePort"`
+
+	// Whether the referenced service exists in the ingress namespace.
+	ServiceExists bool `json:"serviceExists"`
+
+	// Number of ready endpoint addresses behind the referenced service.
+	ReadyEndpoints int `json:"readyEndpoints"`
+}
+
 // GetIngressDetail returns returns detailed information about an ingress
 func GetIngressDetail(client client.Interface, namespace, name string) (*IngressDetail, error) {
 	log.Printf("Getting details of %s ingress in %s namespace", name, namespace)
@@ -50,14 +82,88 @@ func GetIngressDetail(client client.Interface, namespace, name string) (*Ingress
 		return nil, err
 	}
 
-	return getIngressDetail(rawIngress), nil
+	backendHealth, err := getBackendHealth(client, rawIngress)
+	nonCriticalErrors, criticalError := kdErrors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	tlsCertificates := getTLSCertificates(client, rawIngress)
+
+	return getIngressDetail(rawIngress, backendHealth, tlsCertificates, nonCriticalErrors), nil
 }
 
-func getIngressDetail(rawIngress *extensions.Ingress) *IngressDetail {
+func getIngressDetail(rawIngress *extensions.Ingress, backendHealth []BackendHealth,
+	tlsCertificates []TLSCertificateInfo, nonCriticalErrors []error) *IngressDetail {
 	return &IngressDetail{
-		ObjectMeta: api.NewObjectMeta(rawIngress.ObjectMeta),
-		TypeMeta:   api.NewTypeMeta(api.ResourceKindIngress),
-		Spec:       rawIngress.Spec,
-		Status:     rawIngress.Status,
+		ObjectMeta:       api.NewObjectMeta(rawIngress.ObjectMeta),
+		TypeMeta:         api.NewTypeMeta(api.ResourceKindIngress),
+		Spec:             rawIngress.Spec,
+		Status:           rawIngress.Status,
+		IngressClassName: getIngressClassName(rawIngress),
+		BackendHealth:    backendHealth,
+		TLSCertificates:  tlsCertificates,
+		Errors:           nonCriticalErrors,
 	}
 }
+
+// getBackendHealth resolves every backend referenced by the ingress (the default backend and
+// all rule backends) against the services and endpoints in the ingress namespace.
+func getBackendHealth(client client.Interface, ingress *extensions.Ingress) ([]BackendHealth, error) {
+	result := make([]BackendHealth, 0)
+
+	if ingress.Spec.Backend != nil {
+		health, err := resolveBackend(client, ingress.Namespace, "", "", *ingress.Spec.Backend)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, health)
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			health, err := resolveBackend(client, ingress.Namespace, rule.Host, path.Path, path.Backend)
+			if err != nil {
+				return result, err
+			}
+			result = append(result, health)
+		}
+	}
+
+	return result, nil
+}
+
+func resolveBackend(client client.Interface, namespace, host, path string,
+	backend extensions.IngressBackend) (BackendHealth, error) {
+	health := BackendHealth{
+		Host:        host,
+		Path:        path,
+		ServiceName: backend.ServiceName,
+		ServicePort: backend.ServicePort.String(),
+	}
+
+	_, err := client.CoreV1().Services(namespace).Get(backend.ServiceName, metaV1.GetOptions{})
+	if err != nil {
+		if kdErrors.IsNotFoundError(err) {
+			return health, nil
+		}
+		return health, err
+	}
+	health.ServiceExists = true
+
+	endpoints, err := client.CoreV1().Endpoints(namespace).Get(backend.ServiceName, metaV1.GetOptions{})
+	if err != nil {
+		if kdErrors.IsNotFoundError(err) {
+			return health, nil
+		}
+		return health, err
+	}
+	for _, subset := range endpoints.Subsets {
+		health.ReadyEndpoints += len(subset.Addresses)
+	}
+
+	return health, nil
+}
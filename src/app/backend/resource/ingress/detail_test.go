@@ -0,0 +1,99 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetIngressDetailBackendHealth(t *testing.T) {
+	namespace := "test-namespace"
+	ingress := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-ingress", Namespace: namespace},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path: "/app",
+									Backend: extensions.IngressBackend{
+										ServiceName: "existing-service",
+										ServicePort: intstr.FromInt(80),
+									},
+								},
+								{
+									Path: "/missing",
+									Backend: extensions.IngressBackend{
+										ServiceName: "missing-service",
+										ServicePort: intstr.FromInt(80),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "existing-service", Namespace: namespace},
+	}
+	endpoints := &v1.Endpoints{
+		ObjectMeta: metaV1.ObjectMeta{Name: "existing-service", Namespace: namespace},
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+			},
+		},
+	}
+
+	expected := []BackendHealth{
+		{
+			Host:           "example.com",
+			Path:           "/app",
+			ServiceName:    "existing-service",
+			ServicePort:    "80",
+			ServiceExists:  true,
+			ReadyEndpoints: 2,
+		},
+		{
+			Host:        "example.com",
+			Path:        "/missing",
+			ServiceName: "missing-service",
+			ServicePort: "80",
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(ingress, service, endpoints)
+	actual, err := GetIngressDetail(fakeClient, namespace, "test-ingress")
+	if err != nil {
+		t.Errorf("GetIngressDetail(client, %s, test-ingress) returned error: %s", namespace, err)
+	}
+
+	if !reflect.DeepEqual(actual.BackendHealth, expected) {
+		t.Errorf("GetIngressDetail(client, %s, test-ingress) == \ngot backend health %#v, \nexpected %#v",
+			namespace, actual.BackendHealth, expected)
+	}
+}
@@ -30,6 +30,9 @@ type Ingress struct {
 
 	// External endpoints of this ingress.
 	Endpoints []common.Endpoint `json:"endpoints"`
+
+	// Class of this ingress, normalized to the networking.k8s.io ingressClassName shape.
+	IngressClassName *string `json:"ingressClassName"`
 }
 
 // IngressList - response structure for a queried ingress list.
@@ -82,9 +85,10 @@ func getEndpoints(ingress *extensions.Ingress) []common.Endpoint {
 
 func toIngress(ingress *extensions.Ingress) *Ingress {
 	modelIngress := &Ingress{
-		ObjectMeta: api.NewObjectMeta(ingress.ObjectMeta),
-		TypeMeta:   api.NewTypeMeta(api.ResourceKindIngress),
-		Endpoints:  getEndpoints(ingress),
+		ObjectMeta:       api.NewObjectMeta(ingress.ObjectMeta),
+		TypeMeta:         api.NewTypeMeta(api.ResourceKindIngress),
+		Endpoints:        getEndpoints(ingress),
+		IngressClassName: getIngressClassName(ingress),
 	}
 	return modelIngress
 }
@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	kdErrors "github.com/kubernetes/dashboard/src/app/backend/errors"
+	"k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// TLSCertificateInfo describes the certificate stored in a secret referenced by an ingress
+// TLS entry.
+type TLSCertificateInfo struct {
+	// Name of the referenced secret.
+	SecretName string `json:"secretName"`
+
+	// Hosts the TLS entry applies to.
+	Hosts []string `json:"hosts"`
+
+	// Issuer of the certificate.
+	Issuer string `json:"issuer"`
+
+	// Subject alternative names of the certificate.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames"`
+
+	// Expiry timestamp (notAfter) of the certificate.
+	NotAfter *time.Time `json:"notAfter"`
+
+	// Error set when the secret is missing or does not contain a valid certificate.
+	Error string `json:"error"`
+}
+
+// getTLSCertificates reads the secrets referenced by the ingress TLS entries and parses the
+// certificate stored under the tls.crt key. Secrets that are missing or do not hold a valid
+// certificate are reported through the Error field instead of failing the whole detail.
+func getTLSCertificates(client client.Interface, ingress *extensions.Ingress) []TLSCertificateInfo {
+	result := make([]TLSCertificateInfo, 0)
+
+	for _, tls := range ingress.Spec.TLS {
+		info := TLSCertificateInfo{
+			SecretName: tls.SecretName,
+			Hosts:      tls.Hosts,
+		}
+
+		secret, err := client.CoreV1().Secrets(ingress.Namespace).Get(tls.SecretName,
+			metaV1.GetOptions{})
+		if err != nil {
+			info.Error = kdErrors.LocalizeError(err).Error()
+			result = append(result, info)
+			continue
+		}
+
+		cert, err := parseTLSCertificate(secret)
+		if err != nil {
+			info.Error = err.Error()
+			result = append(result, info)
+			continue
+		}
+
+		info.Issuer = cert.Issuer.String()
+		info.SubjectAlternativeNames = cert.DNSNames
+		notAfter := cert.NotAfter
+		info.NotAfter = &notAfter
+		result = append(result, info)
+	}
+
+	return result
+}
+
+// parseTLSCertificate parses the leaf certificate stored under the tls.crt key of a secret.
+func parseTLSCertificate(secret *v1.Secret) (*x509.Certificate, error) {
+	data, ok := secret.Data[v1.TLSCertKey]
+	if !ok {
+		return nil, fmt.Errorf("Secret %s does not contain a %s key", secret.Name, v1.TLSCertKey)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("Secret %s does not contain PEM data under the %s key",
+			secret.Name, v1.TLSCertKey)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Secret %s does not contain a valid certificate: %s",
+			secret.Name, err)
+	}
+
+	return cert, nil
+}
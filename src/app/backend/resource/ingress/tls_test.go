@@ -0,0 +1,103 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// selfSignedCertificate generates a PEM encoded self-signed certificate for tests.
+func selfSignedCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		Issuer:       pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestGetTLSCertificates(t *testing.T) {
+	namespace := "test-namespace"
+	notAfter := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ingress := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-ingress", Namespace: namespace},
+		Spec: extensions.IngressSpec{
+			TLS: []extensions.IngressTLS{
+				{Hosts: []string{"example.com"}, SecretName: "tls-secret"},
+				{Hosts: []string{"broken.example.com"}, SecretName: "broken-secret"},
+			},
+		},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "tls-secret", Namespace: namespace},
+		Data:       map[string][]byte{v1.TLSCertKey: selfSignedCertificate(t, notAfter)},
+	}
+	brokenSecret := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "broken-secret", Namespace: namespace},
+		Data:       map[string][]byte{v1.TLSCertKey: []byte("not a certificate")},
+	}
+
+	fakeClient := fake.NewSimpleClientset(secret, brokenSecret)
+	actual := getTLSCertificates(fakeClient, ingress)
+
+	if len(actual) != 2 {
+		t.Fatalf("getTLSCertificates(client, %#v) returned %d entries, expected 2", ingress,
+			len(actual))
+	}
+
+	if actual[0].NotAfter == nil || !actual[0].NotAfter.Equal(notAfter) {
+		t.Errorf("getTLSCertificates(client, %#v) returned notAfter %v, expected %v", ingress,
+			actual[0].NotAfter, notAfter)
+	}
+	if len(actual[0].SubjectAlternativeNames) != 2 {
+		t.Errorf("getTLSCertificates(client, %#v) returned SANs %v, expected 2 entries", ingress,
+			actual[0].SubjectAlternativeNames)
+	}
+	if actual[0].Error != "" {
+		t.Errorf("getTLSCertificates(client, %#v) returned unexpected error: %s", ingress,
+			actual[0].Error)
+	}
+
+	if actual[1].Error == "" {
+		t.Errorf("getTLSCertificates(client, %#v) expected parse error for broken secret, got none",
+			ingress)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+// JobCleanup describes when a job finished and when the TTL controller will remove it from
+// the cluster.
+type JobCleanup struct {
+	// Time at which the job completed successfully.
+	CompletionTime *metaV1.Time `json:"completionTime"`
+
+	// Time at which the job was marked as failed.
+	FailureTime *metaV1.Time `json:"failureTime"`
+
+	// Duration of the job in seconds, from its start to its completion or failure.
+	DurationSeconds *int64 `json:"durationSeconds"`
+
+	// TTL configured on the job, nil when the job is not cleaned up automatically.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished"`
+
+	// Seconds remaining until the TTL controller removes the finished job, nil when no
+	// cleanup is scheduled.
+	SecondsUntilCleanup *int64 `json:"secondsUntilCleanup"`
+}
+
+// getJobCleanup builds the cleanup summary of a job from its status and the TTL configured
+// on it. A job that has not finished yet gets no duration and no cleanup time.
+func getJobCleanup(job *batch.Job, ttl *int32, now time.Time) JobCleanup {
+	cleanup := JobCleanup{
+		CompletionTime:          job.Status.CompletionTime,
+		TTLSecondsAfterFinished: ttl,
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batch.JobFailed && condition.Status == v1.ConditionTrue {
+			failureTime := condition.LastTransitionTime
+			cleanup.FailureTime = &failureTime
+			break
+		}
+	}
+
+	finishTime := cleanup.CompletionTime
+	if finishTime == nil {
+		finishTime = cleanup.FailureTime
+	}
+	if finishTime == nil {
+		return cleanup
+	}
+
+	if job.Status.StartTime != nil {
+		duration := int64(finishTime.Sub(job.Status.StartTime.Time).Seconds())
+		cleanup.DurationSeconds = &duration
+	}
+
+	if ttl != nil {
+		remaining := int64(finishTime.Add(time.Duration(*ttl) * time.Second).Sub(now).Seconds())
+		if remaining < 0 {
+			remaining = 0
+		}
+		cleanup.SecondsUntilCleanup = &remaining
+	}
+
+	return cleanup
+}
+
+// getJobTTL reads the TTL configured on a job. The vendored batch API predates the
+// ttlSecondsAfterFinished field, so it is read from the raw job object instead of the typed
+// one. Errors are not critical, the job then reports no TTL.
+func getJobTTL(client k8sClient.Interface, namespace, name string) *int32 {
+	restClient, ok := client.BatchV1().RESTClient().(*restclient.RESTClient)
+	if !ok || restClient == nil {
+		return nil
+	}
+
+	rawJob, err := restClient.Get().Namespace(namespace).Resource("jobs").Name(name).Do().Raw()
+	if err != nil {
+		log.Printf("Cannot read TTL of %s job in %s namespace: %s", name, namespace, err)
+		return nil
+	}
+
+	return parseJobTTL(rawJob)
+}
+
+// parseJobTTL extracts the ttlSecondsAfterFinished field from a raw job object, returning
+// nil when the job does not set it.
+func parseJobTTL(rawJob []byte) *int32 {
+	job := struct {
+		Spec struct {
+			TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished"`
+		} `json:"spec"`
+	}{}
+
+	if err := json.Unmarshal(rawJob, &job); err != nil {
+		log.Printf("Cannot parse raw job object: %s", err)
+		return nil
+	}
+
+	return job.Spec.TTLSecondsAfterFinished
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetJobCleanup(t *testing.T) {
+	now := time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC)
+	startTime := metaV1.NewTime(now.Add(-10 * time.Minute))
+	completionTime := metaV1.NewTime(now.Add(-5 * time.Minute))
+	failureTime := metaV1.NewTime(now.Add(-2 * time.Minute))
+	ttl := int32(600)
+	completedDuration := int64(300)
+	completedRemaining := int64(300)
+
+	cases := []struct {
+		job      *batch.Job
+		ttl      *int32
+		expected JobCleanup
+	}{
+		{
+			&batch.Job{},
+			nil,
+			JobCleanup{},
+		},
+		{
+			&batch.Job{
+				Status: batch.JobStatus{
+					StartTime:      &startTime,
+					CompletionTime: &completionTime,
+				},
+			},
+			&ttl,
+			JobCleanup{
+				CompletionTime:          &completionTime,
+				DurationSeconds:         &completedDuration,
+				TTLSecondsAfterFinished: &ttl,
+				SecondsUntilCleanup:     &completedRemaining,
+			},
+		},
+		{
+			&batch.Job{
+				Status: batch.JobStatus{
+					StartTime: &startTime,
+					Conditions: []batch.JobCondition{
+						{
+							Type:               batch.JobFailed,
+							Status:             v1.ConditionTrue,
+							LastTransitionTime: failureTime,
+						},
+					},
+				},
+			},
+			nil,
+			JobCleanup{
+				FailureTime:     &failureTime,
+				DurationSeconds: func(i int64) *int64 { return &i }(480),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := getJobCleanup(c.job, c.ttl, now)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getJobCleanup(%+v, %v, now) == %+v, expected %+v", c.job, c.ttl, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestParseJobTTL(t *testing.T) {
+	ttl := parseJobTTL([]byte(`{"spec":{"ttlSecondsAfterFinished":120,"parallelism":1}}`))
+	if ttl == nil || *ttl != 120 {
+		t.Errorf("parseJobTTL(rawJob) == %v, expected 120", ttl)
+	}
+
+	if ttl := parseJobTTL([]byte(`{"spec":{"parallelism":1}}`)); ttl != nil {
+		t.Errorf("parseJobTTL(rawJob) == %d for a job without TTL, expected nil", *ttl)
+	}
+
+	if ttl := parseJobTTL([]byte(`not-json`)); ttl != nil {
+		t.Errorf("parseJobTTL(rawJob) == %d for a malformed object, expected nil", *ttl)
+	}
+}
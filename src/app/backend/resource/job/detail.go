@@ -15,6 +15,8 @@
 package job
 
 import (
+	"time"
+
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
@@ -54,6 +56,9 @@ type JobDetail struct {
 	// Completions specifies the desired number of successfully finished pods the job should be run with.
 	Completions *int32 `json:"completions"`
 
+	// Cleanup describes when the job finished and when it will be removed by the TTL controller.
+	Cleanup JobCleanup `json:"cleanup"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -85,12 +90,13 @@ func GetJobDetail(client k8sClient.Interface, metricClient metricapi.MetricClien
 		return nil, criticalError
 	}
 
-	job := toJobDetail(jobData, *eventList, *podList, *podInfo, nonCriticalErrors)
+	job := toJobDetail(jobData, *eventList, *podList, *podInfo, getJobTTL(client, namespace, name),
+		nonCriticalErrors)
 	return &job, nil
 }
 
 func toJobDetail(job *batch.Job, eventList common.EventList, podList pod.PodList, podInfo common.PodInfo,
-	nonCriticalErrors []error) JobDetail {
+	ttl *int32, nonCriticalErrors []error) JobDetail {
 	return JobDetail{
 		ObjectMeta:          api.NewObjectMeta(job.ObjectMeta),
 		TypeMeta:            api.NewTypeMeta(api.ResourceKindJob),
@@ -101,6 +107,7 @@ func toJobDetail(job *batch.Job, eventList common.EventList, podList pod.PodList
 		EventList:           eventList,
 		Parallelism:         job.Spec.Parallelism,
 		Completions:         job.Spec.Completions,
+		Cleanup:             getJobCleanup(job, ttl, time.Now()),
 		Errors:              nonCriticalErrors,
 	}
 }
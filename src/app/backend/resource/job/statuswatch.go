@@ -0,0 +1,96 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// JobStatusUpdate contains pod counts of a job. Updates are pushed to clients watching the
+// progress of a job until it completes or fails, which powers live progress bars for batch jobs.
+type JobStatusUpdate struct {
+	// Number of actively running pods.
+	Active int32 `json:"active"`
+
+	// Number of pods that finished successfully.
+	Succeeded int32 `json:"succeeded"`
+
+	// Number of pods that failed.
+	Failed int32 `json:"failed"`
+
+	// Completed is true once the job has a Complete or Failed condition. An update with
+	// Completed set is the last one pushed before the stream is closed.
+	Completed bool `json:"completed"`
+}
+
+// WatchJobStatus starts a watch scoped to a single job.
+func WatchJobStatus(client k8sClient.Interface, namespace, name string) (watch.Interface, error) {
+	return client.BatchV1().Jobs(namespace).Watch(metaV1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+}
+
+// StreamJobStatusUpdates consumes events of given job watch and calls push every time the pod
+// counts of the job change. It returns once the job completes or fails, the watch ends or push
+// returns an error (i.e. the client disconnected). The watch is always stopped before returning.
+func StreamJobStatusUpdates(watcher watch.Interface, push func(JobStatusUpdate) error) error {
+	defer watcher.Stop()
+
+	var lastUpdate *JobStatusUpdate
+	for event := range watcher.ResultChan() {
+		job, ok := event.Object.(*batch.Job)
+		if !ok {
+			continue
+		}
+
+		update := getJobStatusUpdate(job)
+		if lastUpdate != nil && update == *lastUpdate {
+			continue
+		}
+		lastUpdate = &update
+
+		if err := push(update); err != nil {
+			return err
+		}
+		if update.Completed {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// getJobStatusUpdate derives a status update from the status of given job.
+func getJobStatusUpdate(job *batch.Job) JobStatusUpdate {
+	update := JobStatusUpdate{
+		Active:    job.Status.Active,
+		Succeeded: job.Status.Succeeded,
+		Failed:    job.Status.Failed,
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batch.JobComplete || condition.Type == batch.JobFailed) &&
+			condition.Status == v1.ConditionTrue {
+			update.Completed = true
+		}
+	}
+
+	return update
+}
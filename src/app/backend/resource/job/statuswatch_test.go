@@ -0,0 +1,70 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"reflect"
+	"testing"
+
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func createJobWithStatus(active, succeeded, failed int32, conditions []batch.JobCondition) *batch.Job {
+	return &batch.Job{
+		ObjectMeta: metaV1.ObjectMeta{Name: "job-1", Namespace: "ns-1"},
+		Status: batch.JobStatus{
+			Active:     active,
+			Succeeded:  succeeded,
+			Failed:     failed,
+			Conditions: conditions,
+		},
+	}
+}
+
+func TestStreamJobStatusUpdates(t *testing.T) {
+	watcher := watch.NewFake()
+
+	go func() {
+		watcher.Modify(createJobWithStatus(2, 0, 0, nil))
+		// Unchanged status should not produce a duplicate update.
+		watcher.Modify(createJobWithStatus(2, 0, 0, nil))
+		watcher.Modify(createJobWithStatus(1, 1, 0, nil))
+		watcher.Modify(createJobWithStatus(0, 2, 0, []batch.JobCondition{
+			{Type: batch.JobComplete, Status: v1.ConditionTrue},
+		}))
+	}()
+
+	updates := make([]JobStatusUpdate, 0)
+	err := StreamJobStatusUpdates(watcher, func(update JobStatusUpdate) error {
+		updates = append(updates, update)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamJobStatusUpdates(watcher, push) returned error: %s", err)
+	}
+
+	expected := []JobStatusUpdate{
+		{Active: 2},
+		{Active: 1, Succeeded: 1},
+		{Succeeded: 2, Completed: true},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("StreamJobStatusUpdates(watcher, push) pushed \ngot %#v, \nexpected %#v",
+			updates, expected)
+	}
+}
@@ -143,6 +143,9 @@ type LogLines []LogLine
 type LogLine struct {
 	Timestamp LogTimestamp `json:"timestamp"`
 	Content   string       `json:"content"`
+
+	// Severity of the line assigned by Classify, empty when classification was not requested.
+	Severity string `json:"severity,omitempty"`
 }
 
 // LogTimestamp is a timestamp that appears on the beginning of each log line.
@@ -184,6 +187,62 @@ func (self LogLines) SelectLogs(logSelection *Selection) (LogLines, LogTimestamp
 	return self[fromIndex:toIndex], self[fromIndex].Timestamp, self[toIndex-1].Timestamp, newSelection, lastPage
 }
 
+// Filter returns only the log lines whose content contains the given substring, ignoring case.
+// An empty substring matches everything. Line timestamps are left untouched, so line ids stay
+// consistent within the filtered view.
+func (self LogLines) Filter(substring string) LogLines {
+	if len(substring) == 0 {
+		return self
+	}
+	substring = strings.ToLower(substring)
+	filtered := LogLines{}
+	for _, line := range self {
+		if strings.Contains(strings.ToLower(line.Content), substring) {
+			filtered = append(filtered, line)
+		}
+	}
+	return filtered
+}
+
+// Severities assigned to classified log lines.
+const (
+	SeverityError = "error"
+	SeverityWarn  = "warn"
+	SeverityInfo  = "info"
+)
+
+// SeverityPatterns maps severities to the substrings selecting them. Error patterns are
+// checked before warning patterns and lines matching neither are classified as info. The
+// patterns can be overridden to match custom log formats.
+var SeverityPatterns = map[string][]string{
+	SeverityError: {"error", "fatal", "panic", "exception"},
+	SeverityWarn:  {"warn", "deprecated"},
+}
+
+// Classify assigns a severity to every line by case-insensitive substring matching. The raw
+// line content is left intact.
+func (self LogLines) Classify() LogLines {
+	classified := make(LogLines, len(self))
+	for i, line := range self {
+		line.Severity = classifySeverity(line.Content)
+		classified[i] = line
+	}
+	return classified
+}
+
+// classifySeverity returns the severity of a single line of log content.
+func classifySeverity(content string) string {
+	lowered := strings.ToLower(content)
+	for _, severity := range []string{SeverityError, SeverityWarn} {
+		for _, pattern := range SeverityPatterns[severity] {
+			if strings.Contains(lowered, pattern) {
+				return severity
+			}
+		}
+	}
+	return SeverityInfo
+}
+
 // GetLineIndex returns the index of the line (referenced from beginning of log array) with provided logLineId.
 func (self LogLines) getLineIndex(logLineId *LogLineId) int {
 	if logLineId == nil || logLineId.LogTimestamp == NewestTimestamp || len(self) == 0 || logLineId.LogTimestamp == "" {
@@ -0,0 +1,98 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"log"
+	"strings"
+
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NamespaceBatchSpec is a specification of a batch of namespaces to create, used to
+// bootstrap multi-tenant environments in one call.
+type NamespaceBatchSpec struct {
+	// Namespaces to create.
+	Namespaces []BatchNamespace `json:"namespaces"`
+}
+
+// BatchNamespace is a single namespace of a batch creation request.
+type BatchNamespace struct {
+	// Name of the namespace.
+	Name string `json:"name"`
+
+	// Labels to set on the namespace.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to set on the namespace.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// NamespaceBatchResult reports the outcome of a single namespace creation of a batch.
+type NamespaceBatchResult struct {
+	// Name of the namespace.
+	Name string `json:"name"`
+
+	// Success is true when the namespace was created.
+	Success bool `json:"success"`
+
+	// Error explains why the creation failed, empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// NamespaceBatchResultList contains the per-namespace outcomes of a batch creation.
+type NamespaceBatchResultList struct {
+	// Outcomes in the order of the requested namespaces.
+	Results []NamespaceBatchResult `json:"results"`
+}
+
+// CreateNamespaceBatch creates every namespace of the given specification and reports
+// per-namespace success, so one invalid entry does not fail the whole batch. Names that are
+// not valid DNS labels are rejected without calling the API server.
+func CreateNamespaceBatch(spec *NamespaceBatchSpec,
+	client kubernetes.Interface) *NamespaceBatchResultList {
+	log.Printf("Creating batch of %d namespaces", len(spec.Namespaces))
+
+	result := &NamespaceBatchResultList{Results: make([]NamespaceBatchResult, 0)}
+	for _, batchNamespace := range spec.Namespaces {
+		result.Results = append(result.Results, createBatchNamespace(batchNamespace, client))
+	}
+	return result
+}
+
+func createBatchNamespace(batchNamespace BatchNamespace,
+	client kubernetes.Interface) NamespaceBatchResult {
+	if validationErrors := validation.IsDNS1123Label(batchNamespace.Name); len(validationErrors) > 0 {
+		return NamespaceBatchResult{
+			Name:  batchNamespace.Name,
+			Error: strings.Join(validationErrors, ", "),
+		}
+	}
+
+	namespace := &api.Namespace{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        batchNamespace.Name,
+			Labels:      batchNamespace.Labels,
+			Annotations: batchNamespace.Annotations,
+		},
+	}
+	if _, err := client.CoreV1().Namespaces().Create(namespace); err != nil {
+		return NamespaceBatchResult{Name: batchNamespace.Name, Error: err.Error()}
+	}
+	return NamespaceBatchResult{Name: batchNamespace.Name, Success: true}
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateNamespaceBatch(t *testing.T) {
+	spec := &NamespaceBatchSpec{Namespaces: []BatchNamespace{
+		{Name: "team-a", Labels: map[string]string{"team": "a"}},
+		{Name: "Invalid_Name"},
+	}}
+	fakeClient := fake.NewSimpleClientset()
+
+	result := CreateNamespaceBatch(spec, fakeClient)
+
+	if len(result.Results) != 2 {
+		t.Fatalf("CreateNamespaceBatch(%#v) returned %d results, expected 2", spec,
+			len(result.Results))
+	}
+	if !result.Results[0].Success || result.Results[0].Error != "" {
+		t.Errorf("CreateNamespaceBatch(%#v) failed for valid namespace: %#v", spec,
+			result.Results[0])
+	}
+	if result.Results[1].Success || result.Results[1].Error == "" {
+		t.Errorf("CreateNamespaceBatch(%#v) accepted invalid namespace name: %#v", spec,
+			result.Results[1])
+	}
+
+	namespace, err := fakeClient.CoreV1().Namespaces().Get("team-a", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("CreateNamespaceBatch(%#v) did not create valid namespace: %s", spec, err)
+	}
+	if namespace.Labels["team"] != "a" {
+		t.Errorf("CreateNamespaceBatch(%#v) did not set labels, got %#v", spec,
+			namespace.Labels)
+	}
+	if _, err := fakeClient.CoreV1().Namespaces().Get("Invalid_Name", metaV1.GetOptions{}); err == nil {
+		t.Errorf("CreateNamespaceBatch(%#v) created namespace with invalid name", spec)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"log"
+
+	networking "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NetworkPolicyDetail is a presentation layer view of a single Kubernetes NetworkPolicy
+// resource. On top of the summary it carries the full spec, so the rules can be inspected.
+type NetworkPolicyDetail struct {
+	NetworkPolicy `json:",inline"`
+
+	// Spec is the desired state of the Network Policy.
+	Spec networking.NetworkPolicySpec `json:"spec"`
+}
+
+// GetNetworkPolicyDetail returns detailed information about a network policy.
+func GetNetworkPolicyDetail(client kubernetes.Interface, namespace, name string) (
+	*NetworkPolicyDetail, error) {
+	log.Printf("Getting details of %s network policy in %s namespace", name, namespace)
+
+	rawPolicy, err := client.NetworkingV1().NetworkPolicies(namespace).Get(name,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkPolicyDetail{
+		NetworkPolicy: toNetworkPolicy(rawPolicy),
+		Spec:          rawPolicy.Spec,
+	}, nil
+}
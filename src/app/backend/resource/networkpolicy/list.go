@@ -0,0 +1,143 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	networking "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NetworkPolicy is a presentation layer view of Kubernetes NetworkPolicy resource. It
+// summarizes the effect of the policy, so users can see what traffic is allowed.
+type NetworkPolicy struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Label selector of the pods the policy applies to. An empty selector matches all pods
+	// in the namespace.
+	PodSelector metaV1.LabelSelector `json:"podSelector"`
+
+	// Policy types in effect: Ingress, Egress.
+	PolicyTypes []string `json:"policyTypes"`
+
+	// Number of ingress rules of the policy.
+	IngressRuleCount int `json:"ingressRuleCount"`
+
+	// Number of egress rules of the policy.
+	EgressRuleCount int `json:"egressRuleCount"`
+
+	// DefaultDenyIngress is true when the policy selects all pods of the namespace and
+	// allows no ingress traffic at all.
+	DefaultDenyIngress bool `json:"defaultDenyIngress"`
+}
+
+// NetworkPolicyList contains a list of Network Policies in the cluster.
+type NetworkPolicyList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Unordered list of Network Policies.
+	Items []NetworkPolicy `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetNetworkPolicyList returns all network policies in the given namespace.
+func GetNetworkPolicyList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*NetworkPolicyList, error) {
+	log.Printf("Getting list of network policies in the namespace %s", nsQuery.ToRequestParam())
+
+	policyList, err := client.NetworkingV1().NetworkPolicies(nsQuery.ToRequestParam()).
+		List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toNetworkPolicyList(policyList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toNetworkPolicy(policy *networking.NetworkPolicy) NetworkPolicy {
+	return NetworkPolicy{
+		ObjectMeta:         api.NewObjectMeta(policy.ObjectMeta),
+		TypeMeta:           api.NewTypeMeta(api.ResourceKindNetworkPolicy),
+		PodSelector:        policy.Spec.PodSelector,
+		PolicyTypes:        getPolicyTypes(policy.Spec),
+		IngressRuleCount:   len(policy.Spec.Ingress),
+		EgressRuleCount:    len(policy.Spec.Egress),
+		DefaultDenyIngress: isDefaultDenyIngress(policy.Spec),
+	}
+}
+
+func toNetworkPolicyList(policies []networking.NetworkPolicy, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *NetworkPolicyList {
+	result := &NetworkPolicyList{
+		Items:    make([]NetworkPolicy, 0),
+		ListMeta: api.ListMeta{TotalItems: len(policies)},
+		Errors:   nonCriticalErrors,
+	}
+
+	policyCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(policies), dsQuery)
+	policies = fromCells(policyCells)
+	result.ListMeta = api.ListMeta{TotalItems: filteredTotal}
+
+	for i := range policies {
+		result.Items = append(result.Items, toNetworkPolicy(&policies[i]))
+	}
+
+	return result
+}
+
+// getPolicyTypes returns the policy types in effect. An empty policyTypes list defaults to
+// Ingress, plus Egress when egress rules are present.
+func getPolicyTypes(spec networking.NetworkPolicySpec) []string {
+	result := make([]string, 0)
+	if len(spec.PolicyTypes) == 0 {
+		result = append(result, string(networking.PolicyTypeIngress))
+		if len(spec.Egress) > 0 {
+			result = append(result, string(networking.PolicyTypeEgress))
+		}
+		return result
+	}
+
+	for _, policyType := range spec.PolicyTypes {
+		result = append(result, string(policyType))
+	}
+	return result
+}
+
+// isDefaultDenyIngress tells whether the policy blocks all ingress traffic to all pods of its
+// namespace: it selects all pods, affects ingress and allows nothing.
+func isDefaultDenyIngress(spec networking.NetworkPolicySpec) bool {
+	selectsAllPods := len(spec.PodSelector.MatchLabels) == 0 &&
+		len(spec.PodSelector.MatchExpressions) == 0
+	if !selectsAllPods || len(spec.Ingress) > 0 {
+		return false
+	}
+
+	for _, policyType := range getPolicyTypes(spec) {
+		if policyType == string(networking.PolicyTypeIngress) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	networking "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetNetworkPolicyListWithDefaultDenyIngress(t *testing.T) {
+	// A policy selecting all pods with no ingress rules blocks all incoming traffic.
+	defaultDeny := &networking.NetworkPolicy{
+		ObjectMeta: metaV1.ObjectMeta{Name: "default-deny", Namespace: "ns-1"},
+		Spec: networking.NetworkPolicySpec{
+			PolicyTypes: []networking.PolicyType{networking.PolicyTypeIngress},
+		},
+	}
+	scoped := &networking.NetworkPolicy{
+		ObjectMeta: metaV1.ObjectMeta{Name: "allow-db", Namespace: "ns-1"},
+		Spec: networking.NetworkPolicySpec{
+			PodSelector: metaV1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Ingress:     []networking.NetworkPolicyIngressRule{{}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(defaultDeny, scoped)
+
+	actual, err := GetNetworkPolicyList(fakeClient, common.NewSameNamespaceQuery("ns-1"),
+		dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetNetworkPolicyList(client, nsQuery, dsQuery) returned error: %s", err)
+	}
+
+	expected := &NetworkPolicyList{
+		ListMeta: api.ListMeta{TotalItems: 2},
+		Items: []NetworkPolicy{
+			{
+				ObjectMeta:         api.NewObjectMeta(defaultDeny.ObjectMeta),
+				TypeMeta:           api.NewTypeMeta(api.ResourceKindNetworkPolicy),
+				PolicyTypes:        []string{"Ingress"},
+				DefaultDenyIngress: true,
+			},
+			{
+				ObjectMeta:       api.NewObjectMeta(scoped.ObjectMeta),
+				TypeMeta:         api.NewTypeMeta(api.ResourceKindNetworkPolicy),
+				PodSelector:      scoped.Spec.PodSelector,
+				PolicyTypes:      []string{"Ingress"},
+				IngressRuleCount: 1,
+			},
+		},
+		Errors: []error{},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetNetworkPolicyList(client, nsQuery, dsQuery) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -165,7 +165,7 @@ func GetNodeDetail(client k8sClient.Interface, metricClient metricapi.MetricClie
 	}
 
 	metrics, _ := metricPromises.GetMetrics()
-	nodeDetails := toNodeDetail(*node, podList, eventList, allocatedResources, metrics, nonCriticalErrors)
+	nodeDetails := toNodeDetail(*node, &podList.PodList, eventList, allocatedResources, metrics, nonCriticalErrors)
 	return &nodeDetails, nil
 }
 
@@ -281,12 +281,46 @@ func PodRequestsAndLimits(pod *v1.Pod) (reqs map[v1.ResourceName]resource.Quanti
 	return
 }
 
+// NodePodList is a list of pods running on a node together with the share of the node
+// allocatable resources each pod requests.
+type NodePodList struct {
+	pod.PodList
+
+	// Allocation contains per pod requests and their fraction of the node allocatable values.
+	Allocation []PodAllocation `json:"allocation"`
+}
+
+// PodAllocation describes the compute resources a single pod requests from its node.
+type PodAllocation struct {
+	// Name of the pod.
+	Name string `json:"name"`
+
+	// Namespace of the pod.
+	Namespace string `json:"namespace"`
+
+	// CPURequests is the pod CPU request in millicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// CPURequestsFraction is the pod CPU request as a percentage of node allocatable CPU.
+	CPURequestsFraction float64 `json:"cpuRequestsFraction"`
+
+	// MemoryRequests is the pod memory request in bytes.
+	MemoryRequests int64 `json:"memoryRequests"`
+
+	// MemoryRequestsFraction is the pod memory request as a percentage of node allocatable
+	// memory.
+	MemoryRequestsFraction float64 `json:"memoryRequestsFraction"`
+}
+
 // GetNodePods return pods list in given named node
 func GetNodePods(client k8sClient.Interface, metricClient metricapi.MetricClient,
-	dsQuery *dataselect.DataSelectQuery, name string) (*pod.PodList, error) {
-	podList := pod.PodList{
-		Pods:              []pod.Pod{},
-		CumulativeMetrics: []metricapi.Metric{},
+	dsQuery *dataselect.DataSelectQuery, name string) (*NodePodList, error) {
+	podList := NodePodList{
+		PodList: pod.PodList{
+			Pods:              []pod.Pod{},
+			CumulativeMetrics: []metricapi.Metric{},
+		},
+		Allocation: []PodAllocation{},
 	}
 
 	node, err := client.CoreV1().Nodes().Get(name, metaV1.GetOptions{})
@@ -305,10 +339,50 @@ func GetNodePods(client k8sClient.Interface, metricClient metricapi.MetricClient
 		return &podList, criticalError
 	}
 
-	podList = pod.ToPodList(pods.Items, events, nonCriticalErrors, dsQuery, metricClient)
+	allocation, err := getPodAllocations(*node, pods.Items)
+	if err != nil {
+		return &podList, err
+	}
+
+	podList.PodList = pod.ToPodList(pods.Items, events, nonCriticalErrors, dsQuery, metricClient)
+	podList.Allocation = allocation
 	return &podList, nil
 }
 
+// getPodAllocations computes the requests of each pod and their percentage of the node
+// allocatable CPU and memory.
+func getPodAllocations(node v1.Node, pods []v1.Pod) ([]PodAllocation, error) {
+	result := make([]PodAllocation, 0)
+	cpuAllocatable := node.Status.Allocatable.Cpu().MilliValue()
+	memoryAllocatable := node.Status.Allocatable.Memory().Value()
+
+	for _, pod := range pods {
+		reqs, _, err := PodRequestsAndLimits(&pod)
+		if err != nil {
+			return nil, err
+		}
+		cpuRequests, memoryRequests := reqs[v1.ResourceCPU], reqs[v1.ResourceMemory]
+
+		allocation := PodAllocation{
+			Name:           pod.Name,
+			Namespace:      pod.Namespace,
+			CPURequests:    cpuRequests.MilliValue(),
+			MemoryRequests: memoryRequests.Value(),
+		}
+		if cpuAllocatable > 0 {
+			allocation.CPURequestsFraction = float64(allocation.CPURequests) /
+				float64(cpuAllocatable) * 100
+		}
+		if memoryAllocatable > 0 {
+			allocation.MemoryRequestsFraction = float64(allocation.MemoryRequests) /
+				float64(memoryAllocatable) * 100
+		}
+		result = append(result, allocation)
+	}
+
+	return result, nil
+}
+
 func getNodePods(client k8sClient.Interface, node v1.Node) (*v1.PodList, error) {
 	fieldSelector, err := fields.ParseSelector("spec.nodeName=" + node.Name +
 		",status.phase!=" + string(v1.PodSucceeded) +
@@ -24,6 +24,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/pod"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -92,3 +93,69 @@ func TestGetNodeDetail(t *testing.T) {
 		}
 	}
 }
+
+func TestGetNodePodsAllocation(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-node"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+	}
+	podWithRequests := func(name, cpu, memory string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1.PodSpec{
+				NodeName: "test-node",
+				Containers: []v1.Container{
+					{
+						Name:  "test-container",
+						Image: "test-image",
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse(cpu),
+								v1.ResourceMemory: resource.MustParse(memory),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	fakeClient := fake.NewSimpleClientset(node,
+		podWithRequests("pod-1", "500m", "1Gi"),
+		podWithRequests("pod-2", "1", "2Gi"))
+
+	actual, err := GetNodePods(fakeClient, nil, dataselect.NoDataSelect, "test-node")
+	if err != nil {
+		t.Fatalf("GetNodePods(client, nil, dsQuery, test-node) returned error: %s", err)
+	}
+
+	gi := int64(1024 * 1024 * 1024)
+	expected := []PodAllocation{
+		{
+			Name:                   "pod-1",
+			Namespace:              "default",
+			CPURequests:            500,
+			CPURequestsFraction:    25,
+			MemoryRequests:         gi,
+			MemoryRequestsFraction: 25,
+		},
+		{
+			Name:                   "pod-2",
+			Namespace:              "default",
+			CPURequests:            1000,
+			CPURequestsFraction:    50,
+			MemoryRequests:         2 * gi,
+			MemoryRequestsFraction: 50,
+		},
+	}
+
+	if !reflect.DeepEqual(actual.Allocation, expected) {
+		t.Errorf("GetNodePods(client, nil, dsQuery, test-node) == \ngot allocation %#v, \nexpected %#v",
+			actual.Allocation, expected)
+	}
+}
@@ -43,6 +43,20 @@ type Node struct {
 	TypeMeta           api.TypeMeta           `json:"typeMeta"`
 	Ready              v1.ConditionStatus     `json:"ready"`
 	AllocatedResources NodeAllocatedResources `json:"allocatedResources"`
+
+	// PressureConditions are the pressure conditions currently active on the node.
+	PressureConditions []v1.NodeConditionType `json:"pressureConditions"`
+
+	// PodCount is the number of pods scheduled on the node.
+	PodCount int `json:"podCount"`
+}
+
+// pressureConditionTypes are the node conditions reported in the list when active. PIDPressure
+// is matched by name because the vendored API predates its constant.
+var pressureConditionTypes = []v1.NodeConditionType{
+	v1.NodeMemoryPressure,
+	v1.NodeDiskPressure,
+	v1.NodeConditionType("PIDPressure"),
 }
 
 // GetNodeListFromChannels returns a list of all Nodes in the cluster.
@@ -109,12 +123,30 @@ func toNode(node v1.Node, pods *v1.PodList) Node {
 		log.Printf("Couldn't get allocated resources of %s node: %s\n", node.Name, err)
 	}
 
+	podCount := 0
+	if pods != nil {
+		podCount = len(pods.Items)
+	}
+
 	return Node{
 		ObjectMeta:         api.NewObjectMeta(node.ObjectMeta),
 		TypeMeta:           api.NewTypeMeta(api.ResourceKindNode),
 		Ready:              getNodeConditionStatus(node, v1.NodeReady),
 		AllocatedResources: allocatedResources,
+		PressureConditions: getActivePressureConditions(node),
+		PodCount:           podCount,
+	}
+}
+
+// getActivePressureConditions returns the pressure conditions of a node that are true.
+func getActivePressureConditions(node v1.Node) []v1.NodeConditionType {
+	active := make([]v1.NodeConditionType, 0)
+	for _, conditionType := range pressureConditionTypes {
+		if getNodeConditionStatus(node, conditionType) == v1.ConditionTrue {
+			active = append(active, conditionType)
+		}
 	}
+	return active
 }
 
 func getNodeConditionStatus(node v1.Node, conditionType v1.NodeConditionType) v1.ConditionStatus {
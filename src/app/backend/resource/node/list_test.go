@@ -63,6 +63,33 @@ func TestGetNodeList(t *testing.T) {
 						PodCapacity:            0,
 						PodFraction:            0,
 					},
+					PressureConditions: []v1.NodeConditionType{},
+				},
+				},
+			},
+		},
+		{
+			&v1.Node{
+				ObjectMeta: metaV1.ObjectMeta{Name: "pressured-node"},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{Type: v1.NodeReady, Status: v1.ConditionFalse},
+						{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue},
+						{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+					},
+				},
+			},
+			&NodeList{
+				ListMeta: api.ListMeta{
+					TotalItems: 1,
+				},
+				Errors:            []error{},
+				CumulativeMetrics: make([]metricapi.Metric, 0),
+				Nodes: []Node{{
+					ObjectMeta:         api.ObjectMeta{Name: "pressured-node"},
+					TypeMeta:           api.TypeMeta{Kind: api.ResourceKindNode},
+					Ready:              "False",
+					PressureConditions: []v1.NodeConditionType{v1.NodeDiskPressure},
 				},
 				},
 			},
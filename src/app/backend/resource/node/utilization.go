@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"log"
+
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// NodeUtilization is a view of the most recent node usage normalized against node allocatable
+// resources, so the frontend can show percentages without knowing the units of raw metrics.
+type NodeUtilization struct {
+	// Name of the node.
+	Name string `json:"name"`
+
+	// Most recent CPU usage as a percentage of allocatable CPU. Null when metrics for the
+	// node are not available.
+	CPUUtilization *float64 `json:"cpuUtilization"`
+
+	// Most recent memory usage as a percentage of allocatable memory. Null when metrics for
+	// the node are not available.
+	MemoryUtilization *float64 `json:"memoryUtilization"`
+}
+
+// GetNodeUtilization returns CPU and memory usage of a node over the default metric window as
+// percentages of its allocatable resources. Missing metrics result in null percentages rather
+// than an error.
+func GetNodeUtilization(client k8sClient.Interface, metricClient metricapi.MetricClient,
+	name string) (*NodeUtilization, error) {
+	log.Printf("Getting utilization of %s node", name)
+
+	node, err := client.CoreV1().Nodes().Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := &NodeUtilization{Name: node.Name}
+	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort,
+		dataselect.NoFilter, dataselect.StandardMetrics)
+	_, metricPromises := dataselect.GenericDataSelectWithMetrics(toCells([]v1.Node{*node}),
+		dsQuery, metricapi.NoResourceCache, metricClient)
+
+	metrics, err := metricPromises.GetMetrics()
+	if err != nil {
+		log.Printf("Skipping utilization of %s node because of error: %s", name, err)
+		return utilization, nil
+	}
+
+	for _, metric := range metrics {
+		if len(metric.MetricPoints) == 0 {
+			continue
+		}
+		latest := float64(metric.MetricPoints[len(metric.MetricPoints)-1].Value)
+
+		switch metric.MetricName {
+		case metricapi.CpuUsage:
+			if allocatable := node.Status.Allocatable.Cpu().MilliValue(); allocatable > 0 {
+				value := latest / float64(allocatable) * 100
+				utilization.CPUUtilization = &value
+			}
+		case metricapi.MemoryUsage:
+			if allocatable := node.Status.Allocatable.Memory().Value(); allocatable > 0 {
+				value := latest / float64(allocatable) * 100
+				utilization.MemoryUtilization = &value
+			}
+		}
+	}
+
+	return utilization, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"testing"
+
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fixedMetricClient returns a single known data point for every requested metric.
+type fixedMetricClient struct {
+	values map[string]uint64
+}
+
+func (self fixedMetricClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	promises := metricapi.NewMetricPromises(1)
+	value, exists := self.values[metricName]
+	if !exists {
+		promises.PutMetrics([]metricapi.Metric{{MetricName: metricName}}, nil)
+		return promises
+	}
+
+	promises.PutMetrics([]metricapi.Metric{{
+		MetricName:   metricName,
+		MetricPoints: []metricapi.MetricPoint{{Value: value}},
+	}}, nil)
+	return promises
+}
+
+func (self fixedMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := make(metricapi.MetricPromises, 0)
+	for _, metricName := range metricNames {
+		result = append(result, self.DownloadMetric(selectors, metricName, cachedResources)...)
+	}
+	return result
+}
+
+func (fixedMetricClient) AggregateMetrics(metrics metricapi.MetricPromises, metricName string,
+	aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return metrics
+}
+
+func (fixedMetricClient) HealthCheck() error {
+	return nil
+}
+
+func (fixedMetricClient) ID() integrationapi.IntegrationID {
+	return integrationapi.HeapsterIntegrationID
+}
+
+func TestGetNodeUtilization(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-node"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+	})
+
+	metricClient := fixedMetricClient{values: map[string]uint64{
+		// 500 of 2000 allocatable millicores and 1Gi of 4Gi allocatable memory.
+		metricapi.CpuUsage:    500,
+		metricapi.MemoryUsage: 1024 * 1024 * 1024,
+	}}
+
+	utilization, err := GetNodeUtilization(fakeClient, metricClient, "test-node")
+	if err != nil {
+		t.Fatalf("GetNodeUtilization(client, metricClient, %s) returned error: %s", "test-node", err)
+	}
+
+	if utilization.CPUUtilization == nil || *utilization.CPUUtilization != 25 {
+		t.Errorf("GetNodeUtilization() CPUUtilization == %v, expected 25", utilization.CPUUtilization)
+	}
+	if utilization.MemoryUtilization == nil || *utilization.MemoryUtilization != 25 {
+		t.Errorf("GetNodeUtilization() MemoryUtilization == %v, expected 25",
+			utilization.MemoryUtilization)
+	}
+}
+
+func TestGetNodeUtilizationMissingMetrics(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metaV1.ObjectMeta{Name: "test-node"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+	})
+
+	utilization, err := GetNodeUtilization(fakeClient, nil, "test-node")
+	if err != nil {
+		t.Fatalf("GetNodeUtilization(client, nil, %s) returned error: %s", "test-node", err)
+	}
+
+	if utilization.CPUUtilization != nil || utilization.MemoryUtilization != nil {
+		t.Errorf("GetNodeUtilization() with no metric client == %+v, expected null utilization",
+			utilization)
+	}
+}
@@ -50,7 +50,7 @@ func GetOverview(client kubernetes.Interface, metricClient metricapi.MetricClien
 		return &Overview{}, err
 	}
 
-	workloadsResources, err := workload.GetWorkloads(client, metricClient, nsQuery, dsQuery)
+	workloadsResources, err := workload.GetWorkloads(client, metricClient, nsQuery, dsQuery, nil, "")
 	if err != nil {
 		return &Overview{}, err
 	}
@@ -27,8 +27,10 @@ import (
 type PersistentVolumeDetail struct {
 	ObjectMeta             api.ObjectMeta                   `json:"objectMeta"`
 	TypeMeta               api.TypeMeta                     `json:"typeMeta"`
+	// Status is the bind phase of the volume, e.g. "Available" or "Bound".
 	Status                 v1.PersistentVolumePhase         `json:"status"`
 	Claim                  string                           `json:"claim"`
+	BoundPVC               *BoundPersistentVolumeClaim      `json:"boundPVC"`
 	ReclaimPolicy          v1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
 	AccessModes            []v1.PersistentVolumeAccessMode  `json:"accessModes"`
 	StorageClass           string                           `json:"storageClass"`
@@ -38,6 +40,27 @@ type PersistentVolumeDetail struct {
 	Reason                 string                           `json:"reason"`
 }
 
+// BoundPersistentVolumeClaim identifies the claim that has bound a persistent volume.
+type BoundPersistentVolumeClaim struct {
+	// Namespace of the claim.
+	Namespace string `json:"namespace"`
+
+	// Name of the claim.
+	Name string `json:"name"`
+}
+
+// getBoundPersistentVolumeClaim returns the claim bound to the volume, nil for unbound volumes.
+func getBoundPersistentVolumeClaim(pv *v1.PersistentVolume) *BoundPersistentVolumeClaim {
+	if pv.Spec.ClaimRef == nil {
+		return nil
+	}
+
+	return &BoundPersistentVolumeClaim{
+		Namespace: pv.Spec.ClaimRef.Namespace,
+		Name:      pv.Spec.ClaimRef.Name,
+	}
+}
+
 // GetPersistentVolumeDetail returns detailed information about a persistent volume
 func GetPersistentVolumeDetail(client client.Interface, name string) (*PersistentVolumeDetail, error) {
 	log.Printf("Getting details of %s persistent volume", name)
@@ -56,6 +79,7 @@ func getPersistentVolumeDetail(persistentVolume *v1.PersistentVolume) *Persisten
 		TypeMeta:               api.NewTypeMeta(api.ResourceKindPersistentVolume),
 		Status:                 persistentVolume.Status.Phase,
 		Claim:                  getPersistentVolumeClaim(persistentVolume),
+		BoundPVC:               getBoundPersistentVolumeClaim(persistentVolume),
 		ReclaimPolicy:          persistentVolume.Spec.PersistentVolumeReclaimPolicy,
 		AccessModes:            persistentVolume.Spec.AccessModes,
 		StorageClass:           persistentVolume.Spec.StorageClassName,
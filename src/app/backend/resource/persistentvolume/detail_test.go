@@ -61,7 +61,39 @@ func TestGetPersistentVolumeDetail(t *testing.T) {
 				AccessModes:   []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
 				Capacity:      nil,
 				Claim:         "default/myclaim-name",
-				Message:       "my-message",
+				BoundPVC: &BoundPersistentVolumeClaim{
+					Namespace: "default",
+					Name:      "myclaim-name",
+				},
+				Message: "my-message",
+				PersistentVolumeSource: v1.PersistentVolumeSource{
+					HostPath: &v1.HostPathVolumeSource{
+						Path: "my-path",
+					},
+				},
+			},
+		},
+		{
+			"bar",
+			&v1.PersistentVolume{
+				ObjectMeta: metaV1.ObjectMeta{Name: "bar"},
+				Spec: v1.PersistentVolumeSpec{
+					AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+					PersistentVolumeSource: v1.PersistentVolumeSource{
+						HostPath: &v1.HostPathVolumeSource{
+							Path: "my-path",
+						},
+					},
+				},
+				Status: v1.PersistentVolumeStatus{
+					Phase: v1.VolumeAvailable,
+				},
+			},
+			&PersistentVolumeDetail{
+				TypeMeta:    api.TypeMeta{Kind: "persistentvolume"},
+				ObjectMeta:  api.ObjectMeta{Name: "bar"},
+				Status:      v1.VolumeAvailable,
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
 				PersistentVolumeSource: v1.PersistentVolumeSource{
 					HostPath: &v1.HostPathVolumeSource{
 						Path: "my-path",
@@ -0,0 +1,52 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"k8s.io/api/core/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// GetPersistentVolumeClaimEvents gets events associated to a persistent volume claim, e.g.
+// provisioning warnings such as ProvisioningFailed.
+func GetPersistentVolumeClaimEvents(client client.Interface, dsQuery *dataselect.DataSelectQuery,
+	namespace, name string) (*common.EventList, error) {
+	log.Printf("Getting events related to %s persistent volume claim in %s namespace", name,
+		namespace)
+
+	channels := &common.ResourceChannels{
+		EventList: common.GetEventListChannel(client, common.NewSameNamespaceQuery(namespace), 1),
+	}
+
+	eventList := <-channels.EventList.List
+	if err := <-channels.EventList.Error; err != nil {
+		return event.EmptyEventList, err
+	}
+
+	claimEvents := make([]v1.Event, 0)
+	for _, ev := range eventList.Items {
+		if ev.InvolvedObject.Kind == "PersistentVolumeClaim" && ev.InvolvedObject.Name == name {
+			claimEvents = append(claimEvents, ev)
+		}
+	}
+
+	events := event.CreateEventList(event.FillEventsType(claimEvents), dsQuery)
+	return &events, nil
+}
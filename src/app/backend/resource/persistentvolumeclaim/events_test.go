@@ -0,0 +1,76 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetPersistentVolumeClaimEvents(t *testing.T) {
+	namespace := "test-namespace"
+	eventList := &v1.EventList{Items: []v1.Event{
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "event-1", Namespace: namespace},
+			Message:    "test-message",
+			Reason:     "ProvisioningFailed",
+			Type:       v1.EventTypeWarning,
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "PersistentVolumeClaim",
+				Name:      "test-claim",
+				Namespace: namespace,
+			},
+		},
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "event-2", Namespace: namespace},
+			Message:    "other-claim-message",
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "PersistentVolumeClaim",
+				Name:      "other-claim",
+				Namespace: namespace,
+			},
+		},
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "event-3", Namespace: namespace},
+			Message:    "pod-message",
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "Pod",
+				Name:      "test-claim",
+				Namespace: namespace,
+			},
+		},
+	}}
+
+	fakeClient := fake.NewSimpleClientset(eventList)
+	actual, err := GetPersistentVolumeClaimEvents(fakeClient, dataselect.NoDataSelect, namespace,
+		"test-claim")
+	if err != nil {
+		t.Errorf("GetPersistentVolumeClaimEvents(client, nil, %s, test-claim) returned error: %s",
+			namespace, err)
+	}
+
+	if len(actual.Events) != 1 {
+		t.Fatalf("GetPersistentVolumeClaimEvents(client, nil, %s, test-claim) returned %d events, "+
+			"expected 1: %#v", namespace, len(actual.Events), actual.Events)
+	}
+	if actual.Events[0].Message != "test-message" {
+		t.Errorf("GetPersistentVolumeClaimEvents(client, nil, %s, test-claim) returned event %#v, "+
+			"expected message test-message", namespace, actual.Events[0])
+	}
+}
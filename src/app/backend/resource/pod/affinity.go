@@ -0,0 +1,134 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Affinity rule types exposed in the pod detail view.
+const (
+	AffinityTypeNode    = "nodeAffinity"
+	AffinityTypePod     = "podAffinity"
+	AffinityTypePodAnti = "podAntiAffinity"
+)
+
+// AffinityRule is a normalized representation of a single affinity or anti-affinity rule of a
+// pod, so the UI can explain placement constraints without parsing the raw spec.
+type AffinityRule struct {
+	// Type of the rule: "nodeAffinity", "podAffinity" or "podAntiAffinity".
+	Type string `json:"type"`
+
+	// True when the rule is required during scheduling, false when it is only preferred.
+	Required bool `json:"required"`
+
+	// Weight of a preferred rule, 0 for required rules.
+	Weight int32 `json:"weight"`
+
+	// Match expressions of the rule, e.g. "disktype In ssd" or "app=frontend".
+	Expressions []string `json:"expressions"`
+
+	// Topology key of pod (anti-)affinity rules, empty for node affinity.
+	TopologyKey string `json:"topologyKey"`
+}
+
+// getPodAffinity normalizes the affinity section of the pod spec into a flat list of rules.
+func getPodAffinity(pod *v1.Pod) []AffinityRule {
+	rules := make([]AffinityRule, 0)
+	affinity := pod.Spec.Affinity
+	if affinity == nil {
+		return rules
+	}
+
+	if nodeAffinity := affinity.NodeAffinity; nodeAffinity != nil {
+		if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			for _, term := range required.NodeSelectorTerms {
+				rules = append(rules, AffinityRule{
+					Type:        AffinityTypeNode,
+					Required:    true,
+					Expressions: nodeSelectorTermExpressions(term),
+				})
+			}
+		}
+		for _, term := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, AffinityRule{
+				Type:        AffinityTypeNode,
+				Weight:      term.Weight,
+				Expressions: nodeSelectorTermExpressions(term.Preference),
+			})
+		}
+	}
+
+	if podAffinity := affinity.PodAffinity; podAffinity != nil {
+		for _, term := range podAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, toPodAffinityRule(AffinityTypePod, true, 0, term))
+		}
+		for _, term := range podAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, toPodAffinityRule(AffinityTypePod, false, term.Weight,
+				term.PodAffinityTerm))
+		}
+	}
+
+	if podAntiAffinity := affinity.PodAntiAffinity; podAntiAffinity != nil {
+		for _, term := range podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, toPodAffinityRule(AffinityTypePodAnti, true, 0, term))
+		}
+		for _, term := range podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			rules = append(rules, toPodAffinityRule(AffinityTypePodAnti, false, term.Weight,
+				term.PodAffinityTerm))
+		}
+	}
+
+	return rules
+}
+
+// nodeSelectorTermExpressions renders match expressions and match fields of a node selector
+// term as human readable strings.
+func nodeSelectorTermExpressions(term v1.NodeSelectorTerm) []string {
+	expressions := make([]string, 0)
+	for _, expression := range term.MatchExpressions {
+		rendered := fmt.Sprintf("%s %s", expression.Key, expression.Operator)
+		if len(expression.Values) > 0 {
+			rendered = fmt.Sprintf("%s %s", rendered, strings.Join(expression.Values, ","))
+		}
+		expressions = append(expressions, rendered)
+	}
+	return expressions
+}
+
+// toPodAffinityRule normalizes a single pod (anti-)affinity term. The label selector is
+// rendered in its canonical string form, e.g. "app=frontend".
+func toPodAffinityRule(affinityType string, required bool, weight int32,
+	term v1.PodAffinityTerm) AffinityRule {
+
+	expressions := make([]string, 0)
+	if term.LabelSelector != nil {
+		if selector, err := metaV1.LabelSelectorAsSelector(term.LabelSelector); err == nil {
+			expressions = append(expressions, selector.String())
+		}
+	}
+
+	return AffinityRule{
+		Type:        affinityType,
+		Required:    required,
+		Weight:      weight,
+		Expressions: expressions,
+		TopologyKey: term.TopologyKey,
+	}
+}
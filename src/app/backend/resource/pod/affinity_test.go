@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetPodAffinity(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"},
+		Spec: v1.PodSpec{
+			Affinity: &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{{
+							MatchExpressions: []v1.NodeSelectorRequirement{{
+								Key:      "disktype",
+								Operator: v1.NodeSelectorOpIn,
+								Values:   []string{"ssd"},
+							}},
+						}},
+					},
+				},
+				PodAntiAffinity: &v1.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{{
+						Weight: 100,
+						PodAffinityTerm: v1.PodAffinityTerm{
+							LabelSelector: &metaV1.LabelSelector{
+								MatchLabels: map[string]string{"app": "frontend"},
+							},
+							TopologyKey: "kubernetes.io/hostname",
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	expected := []AffinityRule{
+		{
+			Type:        AffinityTypeNode,
+			Required:    true,
+			Expressions: []string{"disktype In ssd"},
+		},
+		{
+			Type:        AffinityTypePodAnti,
+			Weight:      100,
+			Expressions: []string{"app=frontend"},
+			TopologyKey: "kubernetes.io/hostname",
+		},
+	}
+
+	actual := getPodAffinity(pod)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getPodAffinity(%#v) == \n%#v\nexpected \n%#v\n", pod, actual, expected)
+	}
+}
+
+func TestGetPodAffinityWithoutAffinity(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"}}
+
+	if actual := getPodAffinity(pod); len(actual) != 0 {
+		t.Errorf("getPodAffinity(%#v) == %#v, expected no rules", pod, actual)
+	}
+}
@@ -49,6 +49,10 @@ type PodDetail struct {
 	InitContainers            []Container                                     `json:"initContainers"`
 	Metrics                   []metricapi.Metric                              `json:"metrics"`
 	Conditions                []common.Condition                              `json:"conditions"`
+	SecurityContext           *PodSecurityContext                             `json:"securityContext"`
+	Volumes                   []PodVolume                                     `json:"volumes"`
+	EphemeralStorage          EphemeralStorage                                `json:"ephemeralStorage"`
+	Affinity                  []AffinityRule                                  `json:"affinity"`
 	EventList                 common.EventList                                `json:"eventList"`
 	PersistentvolumeclaimList persistentvolumeclaim.PersistentVolumeClaimList `json:"persistentVolumeClaimList"`
 
@@ -72,6 +76,45 @@ type Container struct {
 
 	// Command arguments
 	Args []string `json:"args"`
+
+	// Liveness probe of the container, nil if not configured.
+	LivenessProbe *Probe `json:"livenessProbe"`
+
+	// Readiness probe of the container, nil if not configured.
+	ReadinessProbe *Probe `json:"readinessProbe"`
+
+	// Security context of the container, nil if not configured.
+	SecurityContext *ContainerSecurityContext `json:"securityContext"`
+}
+
+// Probe is a presentation layer view of a container health check.
+type Probe struct {
+	// Type of the handler, one of "HTTPGet", "TCPSocket" or "Exec".
+	Type string `json:"type"`
+
+	// Path of an HTTPGet handler, empty otherwise.
+	Path string `json:"path"`
+
+	// Port of an HTTPGet or TCPSocket handler, empty otherwise.
+	Port string `json:"port"`
+
+	// Command of an Exec handler, empty otherwise.
+	Command []string `json:"command"`
+
+	// Number of seconds after the container has started before the probe is initiated.
+	InitialDelaySeconds int32 `json:"initialDelaySeconds"`
+
+	// Number of seconds after which the probe times out.
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+
+	// How often (in seconds) to perform the probe.
+	PeriodSeconds int32 `json:"periodSeconds"`
+
+	// Minimum consecutive successes for the probe to be considered successful.
+	SuccessThreshold int32 `json:"successThreshold"`
+
+	// Minimum consecutive failures for the probe to be considered failed.
+	FailureThreshold int32 `json:"failureThreshold"`
 }
 
 // EnvVar represents an environment variable of a container.
@@ -195,16 +238,49 @@ func extractContainerInfo(containerList []v1.Container, pod *v1.Pod, configMaps
 		vars = append(vars, evalEnvFrom(container, configMaps, secrets)...)
 
 		containers = append(containers, Container{
-			Name:     container.Name,
-			Image:    container.Image,
-			Env:      vars,
-			Commands: container.Command,
-			Args:     container.Args,
+			Name:           container.Name,
+			Image:          container.Image,
+			Env:            vars,
+			Commands:       container.Command,
+			Args:           container.Args,
+			LivenessProbe:   toProbe(container.LivenessProbe),
+			ReadinessProbe:  toProbe(container.ReadinessProbe),
+			SecurityContext: toContainerSecurityContext(container.SecurityContext),
 		})
 	}
 	return containers
 }
 
+// toProbe converts a probe spec to its presentation layer view, nil for a missing probe.
+func toProbe(probe *v1.Probe) *Probe {
+	if probe == nil {
+		return nil
+	}
+
+	result := &Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+
+	switch {
+	case probe.HTTPGet != nil:
+		result.Type = "HTTPGet"
+		result.Path = probe.HTTPGet.Path
+		result.Port = probe.HTTPGet.Port.String()
+	case probe.TCPSocket != nil:
+		result.Type = "TCPSocket"
+		result.Port = probe.TCPSocket.Port.String()
+	case probe.Exec != nil:
+		result.Type = "Exec"
+		result.Command = probe.Exec.Command
+	}
+
+	return result
+}
+
 func toPodDetail(pod *v1.Pod, metrics []metricapi.Metric, configMaps *v1.ConfigMapList, secrets *v1.SecretList,
 	controller controller.ResourceOwner, events *common.EventList,
 	persistentVolumeClaimList *persistentvolumeclaim.PersistentVolumeClaimList, nonCriticalErrors []error) PodDetail {
@@ -221,6 +297,10 @@ func toPodDetail(pod *v1.Pod, metrics []metricapi.Metric, configMaps *v1.ConfigM
 		InitContainers:            extractContainerInfo(pod.Spec.InitContainers, pod, configMaps, secrets),
 		Metrics:                   metrics,
 		Conditions:                getPodConditions(*pod),
+		SecurityContext:           toPodSecurityContext(pod.Spec.SecurityContext),
+		Volumes:                   getPodVolumes(pod),
+		EphemeralStorage:          getEphemeralStorage(pod),
+		Affinity:                  getPodAffinity(pod),
 		EventList:                 *events,
 		PersistentvolumeclaimList: *persistentVolumeClaimList,
 		Errors: nonCriticalErrors,
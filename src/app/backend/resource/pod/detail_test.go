@@ -27,6 +27,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/resource/persistentvolumeclaim"
 	"k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -51,6 +52,8 @@ func TestGetPodDetail(t *testing.T) {
 				Controller:                controller.ResourceOwner{},
 				Containers:                []Container{},
 				InitContainers:            []Container{},
+				Volumes:                   []PodVolume{},
+				Affinity:                  []AffinityRule{},
 				EventList:                 common.EventList{Events: []common.Event{}},
 				Metrics:                   []metricapi.Metric{},
 				PersistentvolumeclaimList: persistentvolumeclaim.PersistentVolumeClaimList{},
@@ -237,3 +240,57 @@ func TestEvalEnvFrom(t *testing.T) {
 		}
 	}
 }
+
+func TestExtractContainerInfoProbes(t *testing.T) {
+	containers := []v1.Container{
+		{
+			Name: "test-container",
+			LivenessProbe: &v1.Probe{
+				Handler: v1.Handler{
+					HTTPGet: &v1.HTTPGetAction{
+						Path: "/healthz",
+						Port: intstr.FromInt(8080),
+					},
+				},
+				InitialDelaySeconds: 5,
+				PeriodSeconds:       10,
+				FailureThreshold:    3,
+			},
+			ReadinessProbe: &v1.Probe{
+				Handler: v1.Handler{
+					TCPSocket: &v1.TCPSocketAction{
+						Port: intstr.FromInt(3306),
+					},
+				},
+				TimeoutSeconds:   1,
+				SuccessThreshold: 1,
+			},
+		},
+	}
+
+	expectedLiveness := &Probe{
+		Type:                "HTTPGet",
+		Path:                "/healthz",
+		Port:                "8080",
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+		FailureThreshold:    3,
+	}
+	expectedReadiness := &Probe{
+		Type:             "TCPSocket",
+		Port:             "3306",
+		TimeoutSeconds:   1,
+		SuccessThreshold: 1,
+	}
+
+	actual := extractContainerInfo(containers, &v1.Pod{}, &v1.ConfigMapList{}, &v1.SecretList{})
+
+	if !reflect.DeepEqual(actual[0].LivenessProbe, expectedLiveness) {
+		t.Errorf("extractContainerInfo(%#v, ...) == \ngot liveness probe %#v, \nexpected %#v",
+			containers, actual[0].LivenessProbe, expectedLiveness)
+	}
+	if !reflect.DeepEqual(actual[0].ReadinessProbe, expectedReadiness) {
+		t.Errorf("extractContainerInfo(%#v, ...) == \ngot readiness probe %#v, \nexpected %#v",
+			containers, actual[0].ReadinessProbe, expectedReadiness)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	policy "k8s.io/api/policy/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// EvictPod evicts given pod using the eviction subresource, so that pod disruption budgets are
+// honored, unlike a plain delete. This makes it safe to use during node maintenance. When a
+// budget blocks the eviction, the apiserver answers with 429 - that error is translated to a
+// message explaining that retrying later may succeed.
+func EvictPod(client k8sClient.Interface, namespace, name string) error {
+	log.Printf("Evicting %s pod in %s namespace", name, namespace)
+
+	eviction := &policy.Eviction{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+
+	err := client.CoreV1().Pods(namespace).Evict(eviction)
+	if k8sErrors.IsTooManyRequests(err) {
+		return errors.NewTooManyRequests(fmt.Sprintf("Cannot evict %s pod in %s namespace: "+
+			"a pod disruption budget is blocking the eviction. Retry later or adjust the "+
+			"budget.", name, namespace))
+	}
+
+	return err
+}
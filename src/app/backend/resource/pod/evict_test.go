@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func TestEvictPod(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"},
+	})
+	// The default reactor does not understand the eviction subresource, so accept it here.
+	fakeClient.PrependReactor("create", "pods",
+		func(action core.Action) (bool, runtime.Object, error) {
+			return action.GetSubresource() == "eviction", nil, nil
+		})
+
+	err := EvictPod(fakeClient, "ns-1", "pod-1")
+	if err != nil {
+		t.Fatalf("EvictPod(client, ns-1, pod-1) returned error: %s", err)
+	}
+
+	evicted := false
+	for _, action := range fakeClient.Actions() {
+		if action.GetVerb() == "create" && action.GetResource().Resource == "pods" &&
+			action.GetSubresource() == "eviction" {
+			evicted = true
+		}
+	}
+	if !evicted {
+		t.Errorf("Expected eviction subresource to be used but got actions: %#v",
+			fakeClient.Actions())
+	}
+}
+
+func TestEvictPodBlockedByDisruptionBudget(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: "ns-1"},
+	})
+	fakeClient.PrependReactor("create", "pods",
+		func(action core.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+			return true, nil, k8sErrors.NewTooManyRequests(
+				"Cannot evict pod as it would violate the pod's disruption budget.", 0)
+		})
+
+	err := EvictPod(fakeClient, "ns-1", "pod-1")
+	if err == nil {
+		t.Fatal("Expected EvictPod(client, ns-1, pod-1) to return error but got nil")
+	}
+	if !k8sErrors.IsTooManyRequests(err) {
+		t.Errorf("Expected a too many requests error but got %#v", err)
+	}
+	if !strings.Contains(err.Error(), "disruption budget") {
+		t.Errorf("Expected error message to mention the disruption budget but got: %s", err)
+	}
+}
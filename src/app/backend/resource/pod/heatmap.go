@@ -0,0 +1,133 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PodUtilization holds current CPU and memory usage of a single pod expressed as a fraction
+// of the requests and limits declared by its containers. A nil fraction means that either no
+// usage sample was available for the pod or the pod does not declare the corresponding
+// request or limit.
+type PodUtilization struct {
+	// Name of the pod.
+	Name string `json:"name"`
+
+	// CPU usage divided by the sum of CPU requests of the pod containers.
+	CPURequestsFraction *float64 `json:"cpuRequestsFraction"`
+
+	// CPU usage divided by the sum of CPU limits of the pod containers.
+	CPULimitsFraction *float64 `json:"cpuLimitsFraction"`
+
+	// Memory usage divided by the sum of memory requests of the pod containers.
+	MemoryRequestsFraction *float64 `json:"memoryRequestsFraction"`
+
+	// Memory usage divided by the sum of memory limits of the pod containers.
+	MemoryLimitsFraction *float64 `json:"memoryLimitsFraction"`
+}
+
+// NamespaceHeatmap contains utilization of every pod in a namespace, used by the UI to render
+// a resource usage heatmap.
+type NamespaceHeatmap struct {
+	// Utilization of pods in the namespace.
+	Pods []PodUtilization `json:"pods"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetNamespaceHeatmap returns current utilization of every pod in the given namespace. Usage
+// of all pods is downloaded from the metric client in a single pass.
+func GetNamespaceHeatmap(client kubernetes.Interface, metricClient metricapi.MetricClient,
+	namespace string) (*NamespaceHeatmap, error) {
+	log.Printf("Getting heatmap of %s namespace", namespace)
+
+	podList, err := client.CoreV1().Pods(namespace).List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	metrics, err := getMetricsPerPod(podList.Items, metricClient, dataselect.StdMetricsDataSelect)
+	if err != nil {
+		// Missing metrics are reported as null fractions, not as a hard failure.
+		nonCriticalErrors = append(nonCriticalErrors, err)
+	}
+
+	return toNamespaceHeatmap(podList.Items, metrics.MetricsMap, nonCriticalErrors), nil
+}
+
+func toNamespaceHeatmap(pods []v1.Pod, metricsMap map[types.UID]PodMetrics,
+	nonCriticalErrors []error) *NamespaceHeatmap {
+	result := &NamespaceHeatmap{
+		Pods:   make([]PodUtilization, 0),
+		Errors: nonCriticalErrors,
+	}
+
+	for _, pod := range pods {
+		metrics := metricsMap[pod.UID]
+		cpuRequests, cpuLimits, memoryRequests, memoryLimits := getPodResourceTotals(&pod)
+
+		result.Pods = append(result.Pods, PodUtilization{
+			Name:                   pod.Name,
+			CPURequestsFraction:    usageFraction(metrics.CPUUsage, cpuRequests),
+			CPULimitsFraction:      usageFraction(metrics.CPUUsage, cpuLimits),
+			MemoryRequestsFraction: usageFraction(metrics.MemoryUsage, memoryRequests),
+			MemoryLimitsFraction:   usageFraction(metrics.MemoryUsage, memoryLimits),
+		})
+	}
+
+	return result
+}
+
+// getPodResourceTotals sums CPU (in milli CPUs) and memory (in bytes) requests and limits
+// over all containers of the pod.
+func getPodResourceTotals(pod *v1.Pod) (cpuRequests, cpuLimits, memoryRequests,
+	memoryLimits int64) {
+	for _, container := range pod.Spec.Containers {
+		if request, exists := container.Resources.Requests[v1.ResourceCPU]; exists {
+			cpuRequests += request.MilliValue()
+		}
+		if limit, exists := container.Resources.Limits[v1.ResourceCPU]; exists {
+			cpuLimits += limit.MilliValue()
+		}
+		if request, exists := container.Resources.Requests[v1.ResourceMemory]; exists {
+			memoryRequests += request.Value()
+		}
+		if limit, exists := container.Resources.Limits[v1.ResourceMemory]; exists {
+			memoryLimits += limit.Value()
+		}
+	}
+	return
+}
+
+// usageFraction divides the most recent usage sample by the allocated amount. It returns nil
+// when there is no sample or nothing was allocated.
+func usageFraction(usage *uint64, allocated int64) *float64 {
+	if usage == nil || allocated == 0 {
+		return nil
+	}
+	fraction := float64(*usage) / float64(allocated)
+	return &fraction
+}
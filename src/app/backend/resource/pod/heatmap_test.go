@@ -0,0 +1,87 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestToNamespaceHeatmap(t *testing.T) {
+	measuredPod := v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "measured-pod", Namespace: "ns-1", UID: "uid-1"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("500m"),
+						v1.ResourceMemory: resource.MustParse("100"),
+					},
+					Limits: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("1"),
+						v1.ResourceMemory: resource.MustParse("200"),
+					},
+				},
+			}},
+		},
+	}
+	unmeasuredPod := v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unmeasured-pod", Namespace: "ns-1", UID: "uid-2"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU: resource.MustParse("250m"),
+					},
+				},
+			}},
+		},
+	}
+
+	cpuUsage, memoryUsage := uint64(250), uint64(50)
+	metricsMap := map[types.UID]PodMetrics{
+		"uid-1": {CPUUsage: &cpuUsage, MemoryUsage: &memoryUsage},
+	}
+
+	half, quarter := 0.5, 0.25
+	expected := &NamespaceHeatmap{
+		Pods: []PodUtilization{
+			{
+				Name:                   "measured-pod",
+				CPURequestsFraction:    &half,
+				CPULimitsFraction:      &quarter,
+				MemoryRequestsFraction: &half,
+				MemoryLimitsFraction:   &quarter,
+			},
+			{
+				// No usage sample, so every fraction stays null.
+				Name: "unmeasured-pod",
+			},
+		},
+		Errors: []error{},
+	}
+
+	actual := toNamespaceHeatmap([]v1.Pod{measuredPod, unmeasuredPod}, metricsMap, []error{})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toNamespaceHeatmap(pods, metricsMap, errors) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -69,6 +69,9 @@ type Pod struct {
 
 	// Name of the Node this Pod runs on.
 	NodeName string `json:"nodeName"`
+
+	// Top-level controller owning this Pod, only filled in the groupBy=owner list mode.
+	Owner *PodOwner `json:"owner,omitempty"`
 }
 
 var EmptyPodList = &PodList{
@@ -82,10 +85,18 @@ var EmptyPodList = &PodList{
 // GetPodList returns a list of all Pods in the cluster.
 func GetPodList(client k8sClient.Interface, metricClient metricapi.MetricClient, nsQuery *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
+	return GetPodListWithOptions(client, metricClient, nsQuery, dsQuery, metaV1.ListOptions{})
+}
+
+// GetPodListWithOptions returns a list of all Pods in the cluster matching the given list
+// options, e.g. a field selector restricting pods to a node or phase.
+func GetPodListWithOptions(client k8sClient.Interface, metricClient metricapi.MetricClient,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+	options metaV1.ListOptions) (*PodList, error) {
 	log.Print("Getting list of all pods in the cluster")
 
 	channels := &common.ResourceChannels{
-		PodList:   common.GetPodListChannelWithOptions(client, nsQuery, metaV1.ListOptions{}, 1),
+		PodList:   common.GetPodListChannelWithOptions(client, nsQuery, options, 1),
 		EventList: common.GetEventListChannel(client, nsQuery, 1),
 	}
 
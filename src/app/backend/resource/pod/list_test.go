@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
@@ -27,6 +28,8 @@ import (
 	"k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
 )
 
 func TestGetPodListFromChannels(t *testing.T) {
@@ -138,3 +141,92 @@ func TestGetPodListFromChannels(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPodListWithOptions(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.PodList{})
+	options := metav1.ListOptions{FieldSelector: "spec.nodeName=node-1"}
+
+	_, err := pod.GetPodListWithOptions(fakeClient, nil, common.NewNamespaceQuery(nil),
+		dataselect.NoDataSelect, options)
+	if err != nil {
+		t.Fatalf("GetPodListWithOptions(client, nil, nsQuery, dsQuery, %+v) returned error: %s",
+			options, err)
+	}
+
+	expectedSelector := "spec.nodeName=node-1"
+	found := false
+	for _, action := range fakeClient.Actions() {
+		listAction, ok := action.(core.ListActionImpl)
+		if !ok || listAction.GetResource().Resource != "pods" {
+			continue
+		}
+		found = true
+		actualSelector := listAction.GetListRestrictions().Fields.String()
+		if actualSelector != expectedSelector {
+			t.Errorf("Unexpected field selector: %s, expected %s", actualSelector,
+				expectedSelector)
+		}
+	}
+	if !found {
+		t.Errorf("Unexpected actions: %v, expected a pod list action", fakeClient.Actions())
+	}
+}
+
+// brokenMetricClient simulates a heapster integration whose backing service is unreachable.
+// Every download fails with an error, as it does when heapster cannot be reached.
+type brokenMetricClient struct{}
+
+func (self brokenMetricClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	return self.DownloadMetrics(selectors, []string{metricName}, cachedResources)
+}
+
+func (brokenMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	promises := metricapi.NewMetricPromises(len(metricNames))
+	promises.PutMetrics(nil, errors.New("heapster is unavailable"))
+	return promises
+}
+
+func (brokenMetricClient) AggregateMetrics(metrics metricapi.MetricPromises, metricName string,
+	aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return metrics
+}
+
+func (brokenMetricClient) HealthCheck() error {
+	return errors.New("heapster is unavailable")
+}
+
+func (brokenMetricClient) ID() integrationapi.IntegrationID {
+	return integrationapi.HeapsterIntegrationID
+}
+
+func TestGetPodListWithoutMetrics(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default"},
+	})
+
+	cases := []struct {
+		name         string
+		metricClient metricapi.MetricClient
+	}{
+		{"nil metric client", nil},
+		{"failing metric client", brokenMetricClient{}},
+	}
+
+	for _, c := range cases {
+		actual, err := pod.GetPodList(fakeClient, c.metricClient, common.NewNamespaceQuery(nil),
+			dataselect.StdMetricsDataSelect)
+		if err != nil {
+			t.Fatalf("GetPodList() with %s returned error: %s", c.name, err)
+		}
+
+		if len(actual.Pods) != 1 {
+			t.Errorf("GetPodList() with %s returned %d pods, expected 1", c.name, len(actual.Pods))
+		}
+		if !reflect.DeepEqual(actual.CumulativeMetrics, make([]metricapi.Metric, 0)) {
+			t.Errorf("GetPodList() with %s returned cumulative metrics %#v, expected empty list",
+				c.name, actual.CumulativeMetrics)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// PodMemoryBreakdown distinguishes the memory subtypes of a pod. OOM decisions are based on
+// the working set, so high total usage with a low working set is not a problem. Subtypes not
+// reported by the metric backend are null.
+type PodMemoryBreakdown struct {
+	// Memory the kernel cannot reclaim, in bytes.
+	WorkingSet *uint64 `json:"workingSet"`
+
+	// Timestamped samples of working set memory over some short period of history.
+	WorkingSetHistory []metricapi.MetricPoint `json:"workingSetHistory"`
+
+	// Anonymous and swap cache memory in bytes.
+	RSS *uint64 `json:"rss"`
+
+	// Timestamped samples of RSS memory over some short period of history.
+	RSSHistory []metricapi.MetricPoint `json:"rssHistory"`
+
+	// Page cache memory in bytes, reclaimable under pressure.
+	Cache *uint64 `json:"cache"`
+
+	// Timestamped samples of page cache memory over some short period of history.
+	CacheHistory []metricapi.MetricPoint `json:"cacheHistory"`
+}
+
+// GetPodMemoryBreakdown downloads the memory subtype metrics of the pod with the given name in
+// the given namespace. Subtypes the metric backend does not report stay null instead of
+// failing the whole breakdown.
+func GetPodMemoryBreakdown(metricClient metricapi.MetricClient, namespace,
+	podName string) (*PodMemoryBreakdown, error) {
+
+	breakdown := &PodMemoryBreakdown{}
+	if metricClient == nil {
+		log.Print("No metric client provided. Skipping memory breakdown.")
+		return breakdown, nil
+	}
+
+	selector := metricapi.ResourceSelector{
+		Namespace:    namespace,
+		ResourceType: api.ResourceKindPod,
+		ResourceName: podName,
+	}
+
+	metrics, err := metricClient.DownloadMetrics([]metricapi.ResourceSelector{selector},
+		[]string{metricapi.MemoryWorkingSet, metricapi.MemoryRSS, metricapi.MemoryCache},
+		metricapi.NoResourceCache).GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, metric := range metrics {
+		if len(metric.MetricPoints) == 0 {
+			continue
+		}
+		latest := metric.MetricPoints[len(metric.MetricPoints)-1].Value
+
+		switch metric.MetricName {
+		case metricapi.MemoryWorkingSet:
+			breakdown.WorkingSet = &latest
+			breakdown.WorkingSetHistory = metric.MetricPoints
+		case metricapi.MemoryRSS:
+			breakdown.RSS = &latest
+			breakdown.RSSHistory = metric.MetricPoints
+		case metricapi.MemoryCache:
+			breakdown.Cache = &latest
+			breakdown.CacheHistory = metric.MetricPoints
+		}
+	}
+
+	return breakdown, nil
+}
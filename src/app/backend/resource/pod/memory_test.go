@@ -0,0 +1,94 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"testing"
+	"time"
+
+	integrationapi "github.com/kubernetes/dashboard/src/app/backend/integration/api"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+)
+
+// fakeMetricClient serves a fixed value per metric name.
+type fakeMetricClient struct {
+	values map[string]uint64
+}
+
+func (client fakeMetricClient) DownloadMetric(selectors []metricapi.ResourceSelector,
+	metricName string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.NewMetricPromises(len(selectors))
+	for i := range selectors {
+		metric := &metricapi.Metric{MetricName: metricName}
+		if value, exists := client.values[metricName]; exists {
+			metric.MetricPoints = []metricapi.MetricPoint{{Timestamp: time.Now(), Value: value}}
+		}
+		result[i].Metric <- metric
+		result[i].Error <- nil
+	}
+	return result
+}
+
+func (client fakeMetricClient) DownloadMetrics(selectors []metricapi.ResourceSelector,
+	metricNames []string, cachedResources *metricapi.CachedResources) metricapi.MetricPromises {
+	result := metricapi.MetricPromises{}
+	for _, metricName := range metricNames {
+		result = append(result, client.DownloadMetric(selectors, metricName, cachedResources)...)
+	}
+	return result
+}
+
+func (client fakeMetricClient) AggregateMetrics(metrics metricapi.MetricPromises,
+	metricName string, aggregations metricapi.AggregationModes) metricapi.MetricPromises {
+	return metrics
+}
+
+func (client fakeMetricClient) HealthCheck() error { return nil }
+
+func (client fakeMetricClient) ID() integrationapi.IntegrationID { return "fake" }
+
+func TestGetPodMemoryBreakdown(t *testing.T) {
+	metricClient := fakeMetricClient{values: map[string]uint64{
+		metricapi.MemoryWorkingSet: 100,
+		metricapi.MemoryRSS:        150,
+	}}
+
+	breakdown, err := GetPodMemoryBreakdown(metricClient, "ns-1", "pod-1")
+	if err != nil {
+		t.Fatalf("GetPodMemoryBreakdown returned error: %v", err)
+	}
+
+	if breakdown.WorkingSet == nil || *breakdown.WorkingSet != 100 {
+		t.Errorf("GetPodMemoryBreakdown returned working set %v, expected 100",
+			breakdown.WorkingSet)
+	}
+	if breakdown.RSS == nil || *breakdown.RSS != 150 {
+		t.Errorf("GetPodMemoryBreakdown returned RSS %v, expected 150", breakdown.RSS)
+	}
+	if breakdown.Cache != nil {
+		t.Errorf("GetPodMemoryBreakdown returned cache %v, expected null for an unreported subtype",
+			*breakdown.Cache)
+	}
+}
+
+func TestGetPodMemoryBreakdownWithoutMetricClient(t *testing.T) {
+	breakdown, err := GetPodMemoryBreakdown(nil, "ns-1", "pod-1")
+	if err != nil {
+		t.Fatalf("GetPodMemoryBreakdown returned error: %v", err)
+	}
+	if breakdown.WorkingSet != nil || breakdown.RSS != nil || breakdown.Cache != nil {
+		t.Errorf("GetPodMemoryBreakdown == %#v, expected empty breakdown", breakdown)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// PodOwner identifies the top-level controller a pod belongs to, so the frontend can group
+// pods by controller without extra calls.
+type PodOwner struct {
+	// Kind of the owning controller, e.g. Deployment or StatefulSet.
+	Kind string `json:"kind"`
+
+	// Name of the owning controller.
+	Name string `json:"name"`
+}
+
+// GetPodListGroupedByOwner returns the pod list with every pod annotated with its top-level
+// owner. It backs the groupBy=owner mode of the pod list endpoint.
+func GetPodListGroupedByOwner(client k8sClient.Interface, metricClient metricapi.MetricClient,
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
+	channels := &common.ResourceChannels{
+		PodList:   common.GetPodListChannel(client, nsQuery, 1),
+		EventList: common.GetEventListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	err := <-channels.PodList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	eventList := <-channels.EventList.List
+	err = <-channels.EventList.Error
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	podList := ToPodList(pods.Items, eventList.Items, nonCriticalErrors, dsQuery, metricClient)
+	podList.Status = getStatus(pods, eventList.Items)
+
+	err = annotatePodOwners(client, nsQuery, pods.Items, &podList)
+	if err != nil {
+		podList.Errors = append(podList.Errors, err)
+	}
+	return &podList, nil
+}
+
+// annotatePodOwners fills the Owner field of the pods on the list by walking the owner
+// references of the raw pods. Pods owned by a replica set that is itself owned by a
+// deployment report the deployment; the replica sets needed for that second hop are listed
+// once.
+func annotatePodOwners(client k8sClient.Interface, nsQuery *common.NamespaceQuery,
+	pods []v1.Pod, podList *PodList) error {
+	replicaSetList, err := client.AppsV1beta2().ReplicaSets(nsQuery.ToRequestParam()).
+		List(metaV1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	replicaSets := make(map[string]*apps.ReplicaSet)
+	for i := range replicaSetList.Items {
+		replicaSet := &replicaSetList.Items[i]
+		replicaSets[replicaSet.Namespace+"/"+replicaSet.Name] = replicaSet
+	}
+
+	owners := make(map[string]*PodOwner)
+	for i := range pods {
+		pod := &pods[i]
+		owners[pod.Namespace+"/"+pod.Name] = getPodOwner(pod, replicaSets)
+	}
+
+	for i := range podList.Pods {
+		objectMeta := podList.Pods[i].ObjectMeta
+		podList.Pods[i].Owner = owners[objectMeta.Namespace+"/"+objectMeta.Name]
+	}
+	return nil
+}
+
+// getPodOwner resolves the top-level controller of the pod, or nil for unmanaged pods.
+func getPodOwner(pod *v1.Pod, replicaSets map[string]*apps.ReplicaSet) *PodOwner {
+	controllerRef := metaV1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return nil
+	}
+
+	if controllerRef.Kind == "ReplicaSet" {
+		if replicaSet, exists := replicaSets[pod.Namespace+"/"+controllerRef.Name]; exists {
+			if replicaSetControllerRef := metaV1.GetControllerOf(replicaSet); replicaSetControllerRef != nil {
+				return &PodOwner{
+					Kind: replicaSetControllerRef.Kind,
+					Name: replicaSetControllerRef.Name,
+				}
+			}
+		}
+	}
+
+	return &PodOwner{Kind: controllerRef.Kind, Name: controllerRef.Name}
+}
@@ -0,0 +1,77 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetPodOwner(t *testing.T) {
+	controller := true
+	replicaSet := &apps.ReplicaSet{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name: "dp-1-12345", Namespace: "ns-1",
+			OwnerReferences: []metaV1.OwnerReference{
+				{Kind: "Deployment", Name: "dp-1", Controller: &controller},
+			},
+		},
+	}
+	replicaSets := map[string]*apps.ReplicaSet{"ns-1/dp-1-12345": replicaSet}
+
+	cases := []struct {
+		info     string
+		pod      *v1.Pod
+		expected *PodOwner
+	}{
+		{
+			"pod owned by a replica set of a deployment reports the deployment",
+			&v1.Pod{ObjectMeta: metaV1.ObjectMeta{
+				Name: "dp-1-12345-abcde", Namespace: "ns-1",
+				OwnerReferences: []metaV1.OwnerReference{
+					{Kind: "ReplicaSet", Name: "dp-1-12345", Controller: &controller},
+				},
+			}},
+			&PodOwner{Kind: "Deployment", Name: "dp-1"},
+		},
+		{
+			"pod owned by a stateful set reports it directly",
+			&v1.Pod{ObjectMeta: metaV1.ObjectMeta{
+				Name: "ss-1-0", Namespace: "ns-1",
+				OwnerReferences: []metaV1.OwnerReference{
+					{Kind: "StatefulSet", Name: "ss-1", Controller: &controller},
+				},
+			}},
+			&PodOwner{Kind: "StatefulSet", Name: "ss-1"},
+		},
+		{
+			"unmanaged pod has no owner",
+			&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "bare-pod", Namespace: "ns-1"}},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		actual := getPodOwner(c.pod, replicaSets)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getPodOwner(%s) == %#v, expected %#v (%s)", c.pod.Name, actual,
+				c.expected, c.info)
+		}
+	}
+}
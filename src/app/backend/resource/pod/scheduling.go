@@ -0,0 +1,122 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/event"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// Event reasons emitted by the scheduler that explain why a pod is not running.
+const (
+	failedSchedulingReason = "FailedScheduling"
+	preemptedReason        = "Preempted"
+)
+
+// insufficientResourcePattern matches the per-resource parts of scheduler messages like
+// "0/3 nodes are available: 3 Insufficient memory, 1 Insufficient cpu.".
+var insufficientResourcePattern = regexp.MustCompile(`(\d+) Insufficient ([a-zA-Z0-9._/-]+)`)
+
+// PodScheduling explains why a pod is pending, based on scheduler events.
+type PodScheduling struct {
+	// Scheduling-related events of the pod, most recent last.
+	Events []SchedulingEvent `json:"events"`
+}
+
+// SchedulingEvent is a single scheduler event of a pod with its message parsed into a
+// structured form where possible.
+type SchedulingEvent struct {
+	// Reason of the event, e.g. FailedScheduling or Preempted.
+	Reason string `json:"reason"`
+
+	// Raw message of the event.
+	Message string `json:"message"`
+
+	// Number of times this event has occurred.
+	Count int32 `json:"count"`
+
+	// The time at which the most recent occurrence of this event was recorded.
+	LastSeen metaV1.Time `json:"lastSeen"`
+
+	// Resources reported as insufficient in the event message, empty when the message does
+	// not mention any.
+	InsufficientResources []InsufficientResource `json:"insufficientResources"`
+}
+
+// InsufficientResource is a resource shortage parsed from a FailedScheduling event message.
+type InsufficientResource struct {
+	// Name of the insufficient resource, e.g. memory or cpu.
+	Resource string `json:"resource"`
+
+	// Number of nodes reporting the shortage.
+	NodeCount int `json:"nodeCount"`
+}
+
+// GetPodScheduling returns the scheduling-related events of the pod with the given name in the
+// given namespace, explaining why the pod is (or was) not scheduled.
+func GetPodScheduling(client client.Interface, namespace, podName string) (*PodScheduling, error) {
+	log.Printf("Getting scheduling events of %s pod in %s namespace", podName, namespace)
+
+	podEvents, err := event.GetPodEvents(client, namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduling := &PodScheduling{Events: make([]SchedulingEvent, 0)}
+	for _, podEvent := range podEvents {
+		if podEvent.Reason != failedSchedulingReason && podEvent.Reason != preemptedReason {
+			continue
+		}
+		scheduling.Events = append(scheduling.Events, toSchedulingEvent(podEvent))
+	}
+
+	return scheduling, nil
+}
+
+// toSchedulingEvent converts a raw scheduler event, parsing resource shortages out of its
+// message.
+func toSchedulingEvent(podEvent v1.Event) SchedulingEvent {
+	return SchedulingEvent{
+		Reason:                podEvent.Reason,
+		Message:               podEvent.Message,
+		Count:                 podEvent.Count,
+		LastSeen:              podEvent.LastTimestamp,
+		InsufficientResources: parseInsufficientResources(podEvent.Message),
+	}
+}
+
+// parseInsufficientResources extracts resource shortages from a scheduler event message.
+func parseInsufficientResources(message string) []InsufficientResource {
+	resources := make([]InsufficientResource, 0)
+	for _, match := range insufficientResourcePattern.FindAllStringSubmatch(message, -1) {
+		nodeCount, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		resources = append(resources, InsufficientResource{
+			// Trim sentence punctuation following the resource name.
+			Resource:  strings.TrimRight(match[2], ".,"),
+			NodeCount: nodeCount,
+		})
+	}
+	return resources
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetPodScheduling(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metaV1.ObjectMeta{
+		Name: "pod-1", Namespace: "ns-1", UID: "test-uid",
+	}}
+	fakeClient := fake.NewSimpleClientset(pod, &v1.EventList{Items: []v1.Event{
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "test-uid"},
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available: 3 Insufficient memory.",
+			Count:          5,
+		},
+		{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "ev-2", Namespace: "ns-1"},
+			InvolvedObject: v1.ObjectReference{UID: "test-uid"},
+			Reason:         "Scheduled",
+			Message:        "Successfully assigned pod-1 to node-1",
+		},
+	}})
+
+	actual, err := GetPodScheduling(fakeClient, "ns-1", "pod-1")
+	if err != nil {
+		t.Fatalf("GetPodScheduling returned error: %v", err)
+	}
+
+	expected := []SchedulingEvent{{
+		Reason:  "FailedScheduling",
+		Message: "0/3 nodes are available: 3 Insufficient memory.",
+		Count:   5,
+		InsufficientResources: []InsufficientResource{
+			{Resource: "memory", NodeCount: 3},
+		},
+	}}
+	if !reflect.DeepEqual(actual.Events, expected) {
+		t.Errorf("GetPodScheduling == \ngot %#v, \nexpected %#v", actual.Events, expected)
+	}
+}
+
+func TestParseInsufficientResources(t *testing.T) {
+	cases := []struct {
+		message  string
+		expected []InsufficientResource
+	}{
+		{
+			"0/5 nodes are available: 2 Insufficient cpu, 3 Insufficient memory.",
+			[]InsufficientResource{
+				{Resource: "cpu", NodeCount: 2},
+				{Resource: "memory", NodeCount: 3},
+			},
+		},
+		{
+			"0/1 nodes are available: 1 node(s) had taints that the pod didn't tolerate.",
+			[]InsufficientResource{},
+		},
+	}
+
+	for _, c := range cases {
+		actual := parseInsufficientResources(c.message)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("parseInsufficientResources(%#v) == %#v, expected %#v", c.message, actual,
+				c.expected)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// PodSearchResult contains pods matched by an image search.
+type PodSearchResult struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Pods running at least one matching image.
+	Pods []PodImageMatch `json:"pods"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// PodImageMatch is a single pod matched by an image search.
+type PodImageMatch struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Images of the pod that matched the search query.
+	Images []string `json:"images"`
+}
+
+// SearchPodsByImage returns all pods in the queried namespaces with an init or regular container
+// whose image contains the given substring, e.g. to find where a vulnerable image runs.
+func SearchPodsByImage(client k8sClient.Interface, nsQuery *common.NamespaceQuery,
+	image string) (*PodSearchResult, error) {
+	if len(image) == 0 {
+		return nil, fmt.Errorf("Image search query must not be empty")
+	}
+
+	log.Printf("Searching for pods with images matching %s", image)
+
+	channels := &common.ResourceChannels{
+		PodList: common.GetPodListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	err := <-channels.PodList.Error
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := &PodSearchResult{
+		Pods:   make([]PodImageMatch, 0),
+		Errors: nonCriticalErrors,
+	}
+	for _, pod := range pods.Items {
+		images := matchingImages(&pod, image)
+		if len(images) == 0 {
+			continue
+		}
+		result.Pods = append(result.Pods, PodImageMatch{
+			ObjectMeta: api.NewObjectMeta(pod.ObjectMeta),
+			TypeMeta:   api.NewTypeMeta(api.ResourceKindPod),
+			Images:     images,
+		})
+	}
+	result.ListMeta = api.ListMeta{TotalItems: len(result.Pods)}
+	return result, nil
+}
+
+// matchingImages returns the distinct images of init and regular containers of the pod that
+// contain the search query.
+func matchingImages(pod *v1.Pod, image string) []string {
+	images := make([]string, 0)
+	seen := make(map[string]bool)
+	match := func(containers []v1.Container) {
+		for _, container := range containers {
+			if strings.Contains(container.Image, image) && !seen[container.Image] {
+				images = append(images, container.Image)
+				seen[container.Image] = true
+			}
+		}
+	}
+	match(pod.Spec.InitContainers)
+	match(pod.Spec.Containers)
+	return images
+}
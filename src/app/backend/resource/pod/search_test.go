@@ -0,0 +1,95 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSearchPodsByImage(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "web-1", Namespace: "ns-1"},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "web", Image: "nginx:1.15.4"}},
+			},
+		},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{Name: "job-1", Namespace: "ns-2"},
+			Spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "init", Image: "busybox:1.29"}},
+				Containers:     []v1.Container{{Name: "main", Image: "redis:4.0"}},
+			},
+		},
+	)
+
+	cases := []struct {
+		image    string
+		expected []PodImageMatch
+	}{
+		{
+			// Partial image reference matching a regular container.
+			"nginx:1.15",
+			[]PodImageMatch{{
+				ObjectMeta: api.ObjectMeta{Name: "web-1", Namespace: "ns-1"},
+				TypeMeta:   api.TypeMeta{Kind: api.ResourceKindPod},
+				Images:     []string{"nginx:1.15.4"},
+			}},
+		},
+		{
+			// Partial image reference matching an init container.
+			"busybox",
+			[]PodImageMatch{{
+				ObjectMeta: api.ObjectMeta{Name: "job-1", Namespace: "ns-2"},
+				TypeMeta:   api.TypeMeta{Kind: api.ResourceKindPod},
+				Images:     []string{"busybox:1.29"},
+			}},
+		},
+		{
+			"image-that-does-not-run", []PodImageMatch{},
+		},
+	}
+
+	for _, c := range cases {
+		result, err := SearchPodsByImage(fakeClient, common.NewNamespaceQuery(nil), c.image)
+		if err != nil {
+			t.Fatalf("SearchPodsByImage(client, nsQuery, %s) returned error: %s", c.image, err)
+		}
+		if !reflect.DeepEqual(result.Pods, c.expected) {
+			t.Errorf("SearchPodsByImage(client, nsQuery, %s) returned %+v, expected %+v",
+				c.image, result.Pods, c.expected)
+		}
+		if result.ListMeta.TotalItems != len(c.expected) {
+			t.Errorf("SearchPodsByImage(client, nsQuery, %s) returned %d total items, expected %d",
+				c.image, result.ListMeta.TotalItems, len(c.expected))
+		}
+	}
+}
+
+func TestSearchPodsByImageEmptyQuery(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+
+	_, err := SearchPodsByImage(fakeClient, common.NewNamespaceQuery(nil), "")
+	if err == nil {
+		t.Fatal("SearchPodsByImage(client, nsQuery, \"\") expected error for empty query, got none")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"k8s.io/api/core/v1"
+)
+
+// PodSecurityContext is a presentation layer view of the pod-level security context, exposing
+// the fields relevant for security audits.
+type PodSecurityContext struct {
+	// The UID to run the entrypoint of the container processes as, nil when not set.
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+
+	// Indicates that the containers must run as a non-root user, nil when not set.
+	RunAsNonRoot *bool `json:"runAsNonRoot,omitempty"`
+}
+
+// ContainerSecurityContext is a presentation layer view of a container-level security context,
+// exposing the fields relevant for security audits.
+type ContainerSecurityContext struct {
+	// The UID to run the entrypoint of the container process as, nil when not set.
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+
+	// Indicates that the container must run as a non-root user, nil when not set.
+	RunAsNonRoot *bool `json:"runAsNonRoot,omitempty"`
+
+	// Whether the container has a read-only root filesystem, nil when not set.
+	ReadOnlyRootFilesystem *bool `json:"readOnlyRootFilesystem,omitempty"`
+
+	// Whether the container runs in privileged mode, nil when not set.
+	Privileged *bool `json:"privileged,omitempty"`
+
+	// Capabilities added to the container.
+	AddedCapabilities []v1.Capability `json:"addedCapabilities,omitempty"`
+
+	// Capabilities dropped from the container.
+	DroppedCapabilities []v1.Capability `json:"droppedCapabilities,omitempty"`
+}
+
+// toPodSecurityContext converts a pod-level security context to its presentation layer view,
+// nil when the pod defines none.
+func toPodSecurityContext(securityContext *v1.PodSecurityContext) *PodSecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	return &PodSecurityContext{
+		RunAsUser:    securityContext.RunAsUser,
+		RunAsNonRoot: securityContext.RunAsNonRoot,
+	}
+}
+
+// toContainerSecurityContext converts a container-level security context to its presentation
+// layer view, nil when the container defines none.
+func toContainerSecurityContext(securityContext *v1.SecurityContext) *ContainerSecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+
+	result := &ContainerSecurityContext{
+		RunAsUser:              securityContext.RunAsUser,
+		RunAsNonRoot:           securityContext.RunAsNonRoot,
+		ReadOnlyRootFilesystem: securityContext.ReadOnlyRootFilesystem,
+		Privileged:             securityContext.Privileged,
+	}
+	if securityContext.Capabilities != nil {
+		result.AddedCapabilities = securityContext.Capabilities.Add
+		result.DroppedCapabilities = securityContext.Capabilities.Drop
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestToPodSecurityContext(t *testing.T) {
+	runAsUser := int64(1000)
+	runAsNonRoot := true
+
+	cases := []struct {
+		securityContext *v1.PodSecurityContext
+		expected        *PodSecurityContext
+	}{
+		{nil, nil},
+		{
+			&v1.PodSecurityContext{RunAsUser: &runAsUser, RunAsNonRoot: &runAsNonRoot},
+			&PodSecurityContext{RunAsUser: &runAsUser, RunAsNonRoot: &runAsNonRoot},
+		},
+	}
+
+	for _, c := range cases {
+		actual := toPodSecurityContext(c.securityContext)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("toPodSecurityContext(%#v) == \ngot %#v, \nexpected %#v",
+				c.securityContext, actual, c.expected)
+		}
+	}
+}
+
+func TestToContainerSecurityContext(t *testing.T) {
+	privileged := true
+	readOnlyRootFilesystem := false
+
+	cases := []struct {
+		securityContext *v1.SecurityContext
+		expected        *ContainerSecurityContext
+	}{
+		{nil, nil},
+		{
+			&v1.SecurityContext{
+				Privileged:             &privileged,
+				ReadOnlyRootFilesystem: &readOnlyRootFilesystem,
+				Capabilities: &v1.Capabilities{
+					Add:  []v1.Capability{"NET_ADMIN"},
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+			&ContainerSecurityContext{
+				Privileged:             &privileged,
+				ReadOnlyRootFilesystem: &readOnlyRootFilesystem,
+				AddedCapabilities:      []v1.Capability{"NET_ADMIN"},
+				DroppedCapabilities:    []v1.Capability{"ALL"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := toContainerSecurityContext(c.securityContext)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("toContainerSecurityContext(%#v) == \ngot %#v, \nexpected %#v",
+				c.securityContext, actual, c.expected)
+		}
+	}
+}
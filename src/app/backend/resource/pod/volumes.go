@@ -0,0 +1,135 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"k8s.io/api/core/v1"
+	res "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// PodVolume is a presentation layer view of a volume of a pod, together with the containers
+// mounting it.
+type PodVolume struct {
+	// Name of the volume.
+	Name string `json:"name"`
+
+	// Type of the volume source, one of "EmptyDir", "PersistentVolumeClaim", "ConfigMap",
+	// "Secret", "HostPath" or "Other".
+	Type string `json:"type"`
+
+	// Name of the referenced object (claim, config map, secret) or the host path, empty for
+	// sources without one.
+	Source string `json:"source"`
+
+	// Mounts of the volume in the containers of the pod.
+	Mounts []PodVolumeMount `json:"mounts"`
+}
+
+// PodVolumeMount describes where a single container mounts a volume.
+type PodVolumeMount struct {
+	// Name of the container mounting the volume.
+	ContainerName string `json:"containerName"`
+
+	// Path within the container at which the volume is mounted.
+	MountPath string `json:"mountPath"`
+
+	// ReadOnly is true when the container cannot write to the volume.
+	ReadOnly bool `json:"readOnly"`
+}
+
+// EphemeralStorage describes how much node-local storage the containers of a pod may use.
+// Usage metrics are not available from the supported metric backends, so requests and limits
+// from the pod spec are surfaced instead.
+type EphemeralStorage struct {
+	// Sum of ephemeral-storage requests of all containers, empty when none is set.
+	Request string `json:"request"`
+
+	// Sum of ephemeral-storage limits of all containers, empty when none is set.
+	Limit string `json:"limit"`
+}
+
+// getPodVolumes builds the volume view of a pod, resolving for every volume its type and
+// the containers that mount it.
+func getPodVolumes(pod *v1.Pod) []PodVolume {
+	volumes := make([]PodVolume, 0)
+	for _, volume := range pod.Spec.Volumes {
+		podVolume := PodVolume{
+			Name:   volume.Name,
+			Mounts: getVolumeMounts(pod, volume.Name),
+		}
+		podVolume.Type, podVolume.Source = toVolumeTypeAndSource(&volume.VolumeSource)
+		volumes = append(volumes, podVolume)
+	}
+	return volumes
+}
+
+// getVolumeMounts collects the mounts of a named volume across all containers of a pod.
+func getVolumeMounts(pod *v1.Pod, volumeName string) []PodVolumeMount {
+	mounts := make([]PodVolumeMount, 0)
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == volumeName {
+				mounts = append(mounts, PodVolumeMount{
+					ContainerName: container.Name,
+					MountPath:     mount.MountPath,
+					ReadOnly:      mount.ReadOnly,
+				})
+			}
+		}
+	}
+	return mounts
+}
+
+// toVolumeTypeAndSource maps a volume source to its display type and the name of the object
+// (or path) it references.
+func toVolumeTypeAndSource(source *v1.VolumeSource) (string, string) {
+	switch {
+	case source.EmptyDir != nil:
+		return "EmptyDir", ""
+	case source.PersistentVolumeClaim != nil:
+		return "PersistentVolumeClaim", source.PersistentVolumeClaim.ClaimName
+	case source.ConfigMap != nil:
+		return "ConfigMap", source.ConfigMap.Name
+	case source.Secret != nil:
+		return "Secret", source.Secret.SecretName
+	case source.HostPath != nil:
+		return "HostPath", source.HostPath.Path
+	}
+	return "Other", ""
+}
+
+// getEphemeralStorage sums the ephemeral-storage requests and limits of all containers of
+// a pod.
+func getEphemeralStorage(pod *v1.Pod) EphemeralStorage {
+	result := EphemeralStorage{}
+	request := res.Quantity{}
+	limit := res.Quantity{}
+	for _, container := range pod.Spec.Containers {
+		if quantity, found := container.Resources.Requests[v1.ResourceEphemeralStorage]; found {
+			request.Add(quantity)
+		}
+		if quantity, found := container.Resources.Limits[v1.ResourceEphemeralStorage]; found {
+			limit.Add(quantity)
+		}
+	}
+	if !request.IsZero() {
+		result.Request = request.String()
+	}
+	if !limit.IsZero() {
+		result.Limit = limit.String()
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	res "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetPodVolumes(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "app",
+					VolumeMounts: []v1.VolumeMount{
+						{Name: "data", MountPath: "/data"},
+						{Name: "cache", MountPath: "/cache"},
+					},
+				},
+				{
+					Name: "sidecar",
+					VolumeMounts: []v1.VolumeMount{
+						{Name: "data", MountPath: "/shared", ReadOnly: true},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name: "data",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "claim-1",
+						},
+					},
+				},
+				{
+					Name:         "cache",
+					VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+				},
+			},
+		},
+	}
+
+	expected := []PodVolume{
+		{
+			Name:   "data",
+			Type:   "PersistentVolumeClaim",
+			Source: "claim-1",
+			Mounts: []PodVolumeMount{
+				{ContainerName: "app", MountPath: "/data"},
+				{ContainerName: "sidecar", MountPath: "/shared", ReadOnly: true},
+			},
+		},
+		{
+			Name:   "cache",
+			Type:   "EmptyDir",
+			Mounts: []PodVolumeMount{{ContainerName: "app", MountPath: "/cache"}},
+		},
+	}
+
+	actual := getPodVolumes(pod)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getPodVolumes(pod) == \n%+v, expected \n%+v", actual, expected)
+	}
+}
+
+func TestGetEphemeralStorage(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "app",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceEphemeralStorage: res.MustParse("1Gi"),
+						},
+						Limits: v1.ResourceList{
+							v1.ResourceEphemeralStorage: res.MustParse("2Gi"),
+						},
+					},
+				},
+				{
+					Name: "sidecar",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceEphemeralStorage: res.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := EphemeralStorage{Request: "2Gi", Limit: "2Gi"}
+	actual := getEphemeralStorage(pod)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("getEphemeralStorage(pod) == %+v, expected %+v", actual, expected)
+	}
+
+	if actual := getEphemeralStorage(&v1.Pod{}); !reflect.DeepEqual(actual, EphemeralStorage{}) {
+		t.Errorf("getEphemeralStorage(pod) == %+v for a pod without requests, expected empty",
+			actual)
+	}
+}
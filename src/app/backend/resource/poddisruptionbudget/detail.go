@@ -0,0 +1,42 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddisruptionbudget
+
+import (
+	"log"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PodDisruptionBudgetDetail is a presentation layer view of a single Kubernetes
+// PodDisruptionBudget resource.
+type PodDisruptionBudgetDetail struct {
+	PodDisruptionBudget `json:",inline"`
+}
+
+// GetPodDisruptionBudgetDetail returns detailed information about a pod disruption budget.
+func GetPodDisruptionBudgetDetail(client kubernetes.Interface, namespace, name string) (
+	*PodDisruptionBudgetDetail, error) {
+	log.Printf("Getting details of %s pod disruption budget in %s namespace", name, namespace)
+
+	rawBudget, err := client.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name,
+		metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PodDisruptionBudgetDetail{PodDisruptionBudget: toPodDisruptionBudget(rawBudget)}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddisruptionbudget
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	policy "k8s.io/api/policy/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PodDisruptionBudget is a presentation layer view of Kubernetes PodDisruptionBudget resource.
+// It shows operators how many pods of a workload can be disrupted, which helps to plan
+// maintenance.
+type PodDisruptionBudget struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Minimum number/percentage of pods of the covered workload that must stay available.
+	MinAvailable *intstr.IntOrString `json:"minAvailable"`
+
+	// Maximum number/percentage of pods of the covered workload that may be unavailable.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable"`
+
+	// Label selector of the pods the budget covers.
+	Selector *metaV1.LabelSelector `json:"selector"`
+
+	// Number of currently healthy pods covered by the budget.
+	CurrentHealthy int32 `json:"currentHealthy"`
+
+	// Minimum number of healthy pods the budget requires.
+	DesiredHealthy int32 `json:"desiredHealthy"`
+
+	// Total number of pods covered by the budget.
+	ExpectedPods int32 `json:"expectedPods"`
+
+	// Number of pod disruptions that are currently allowed.
+	DisruptionsAllowed int32 `json:"disruptionsAllowed"`
+}
+
+// PodDisruptionBudgetList contains a list of Pod Disruption Budgets in the cluster.
+type PodDisruptionBudgetList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Unordered list of Pod Disruption Budgets.
+	Items []PodDisruptionBudget `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetPodDisruptionBudgetList returns all pod disruption budgets in the given namespace.
+func GetPodDisruptionBudgetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*PodDisruptionBudgetList, error) {
+	log.Printf("Getting list of pod disruption budgets in the namespace %s",
+		nsQuery.ToRequestParam())
+
+	budgetList, err := client.PolicyV1beta1().PodDisruptionBudgets(nsQuery.ToRequestParam()).
+		List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toPodDisruptionBudgetList(budgetList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toPodDisruptionBudget(budget *policy.PodDisruptionBudget) PodDisruptionBudget {
+	return PodDisruptionBudget{
+		ObjectMeta:         api.NewObjectMeta(budget.ObjectMeta),
+		TypeMeta:           api.NewTypeMeta(api.ResourceKindPodDisruptionBudget),
+		MinAvailable:       budget.Spec.MinAvailable,
+		MaxUnavailable:     budget.Spec.MaxUnavailable,
+		Selector:           budget.Spec.Selector,
+		CurrentHealthy:     budget.Status.CurrentHealthy,
+		DesiredHealthy:     budget.Status.DesiredHealthy,
+		ExpectedPods:       budget.Status.ExpectedPods,
+		DisruptionsAllowed: budget.Status.PodDisruptionsAllowed,
+	}
+}
+
+func toPodDisruptionBudgetList(budgets []policy.PodDisruptionBudget, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *PodDisruptionBudgetList {
+	result := &PodDisruptionBudgetList{
+		Items:    make([]PodDisruptionBudget, 0),
+		ListMeta: api.ListMeta{TotalItems: len(budgets)},
+		Errors:   nonCriticalErrors,
+	}
+
+	budgetCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(budgets), dsQuery)
+	budgets = fromCells(budgetCells)
+	result.ListMeta = api.ListMeta{TotalItems: filteredTotal}
+
+	for i := range budgets {
+		result.Items = append(result.Items, toPodDisruptionBudget(&budgets[i]))
+	}
+
+	return result
+}
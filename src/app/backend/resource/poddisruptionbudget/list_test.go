@@ -0,0 +1,75 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddisruptionbudget
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	policy "k8s.io/api/policy/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetPodDisruptionBudgetList(t *testing.T) {
+	minAvailable := intstr.FromInt(2)
+	selector := &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+	budget := &policy.PodDisruptionBudget{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pdb-1", Namespace: "ns-1"},
+		Spec: policy.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     selector,
+		},
+		Status: policy.PodDisruptionBudgetStatus{
+			CurrentHealthy:        2,
+			DesiredHealthy:        2,
+			ExpectedPods:          2,
+			PodDisruptionsAllowed: 0,
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(budget)
+
+	actual, err := GetPodDisruptionBudgetList(fakeClient, common.NewSameNamespaceQuery("ns-1"),
+		dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetPodDisruptionBudgetList(client, nsQuery, dsQuery) returned error: %s", err)
+	}
+
+	expected := &PodDisruptionBudgetList{
+		ListMeta: api.ListMeta{TotalItems: 1},
+		Items: []PodDisruptionBudget{
+			{
+				ObjectMeta:         api.NewObjectMeta(budget.ObjectMeta),
+				TypeMeta:           api.NewTypeMeta(api.ResourceKindPodDisruptionBudget),
+				MinAvailable:       &minAvailable,
+				Selector:           selector,
+				CurrentHealthy:     2,
+				DesiredHealthy:     2,
+				ExpectedPods:       2,
+				DisruptionsAllowed: 0,
+			},
+		},
+		Errors: []error{},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetPodDisruptionBudgetList(client, nsQuery, dsQuery) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -39,8 +39,22 @@ type ReplicaSet struct {
 
 	// Init Container images of the Replica Set.
 	InitContainerImages []string `json:"initContainerImages"`
+
+	// Pod template hash from the pod-template-hash label, correlating the Replica Set with
+	// the pods it owns and with its deployment revision.
+	PodTemplateHash string `json:"podTemplateHash"`
+
+	// Deployment rollout revision from the deployment.kubernetes.io/revision annotation,
+	// empty for replica sets not managed by a deployment.
+	Revision string `json:"revision"`
 }
 
+// Label and annotation carrying the deployment rollout identity of a replica set.
+const (
+	podTemplateHashLabel = "pod-template-hash"
+	revisionAnnotation   = "deployment.kubernetes.io/revision"
+)
+
 // ToReplicaSet converts replica set api object to replica set model object.
 func ToReplicaSet(replicaSet *apps.ReplicaSet, podInfo *common.PodInfo) ReplicaSet {
 	return ReplicaSet{
@@ -49,6 +63,8 @@ func ToReplicaSet(replicaSet *apps.ReplicaSet, podInfo *common.PodInfo) ReplicaS
 		ContainerImages:     common.GetContainerImages(&replicaSet.Spec.Template.Spec),
 		InitContainerImages: common.GetInitContainerImages(&replicaSet.Spec.Template.Spec),
 		Pods:                *podInfo,
+		PodTemplateHash:     replicaSet.Labels[podTemplateHashLabel],
+		Revision:            replicaSet.Annotations[revisionAnnotation],
 	}
 }
 
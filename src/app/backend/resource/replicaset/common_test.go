@@ -39,6 +39,23 @@ func TestToReplicaSet(t *testing.T) {
 				Pods:       common.PodInfo{Running: 1, Warnings: []common.Event{}},
 			},
 		},
+		{
+			&apps.ReplicaSet{ObjectMeta: metaV1.ObjectMeta{
+				Name:        "replica-set",
+				Labels:      map[string]string{podTemplateHashLabel: "1234567890"},
+				Annotations: map[string]string{revisionAnnotation: "3"},
+			}},
+			&common.PodInfo{Running: 1, Warnings: []common.Event{}},
+			ReplicaSet{
+				ObjectMeta: api.ObjectMeta{Name: "replica-set",
+					Labels:      map[string]string{podTemplateHashLabel: "1234567890"},
+					Annotations: map[string]string{revisionAnnotation: "3"}},
+				TypeMeta:        api.TypeMeta{Kind: api.ResourceKindReplicaSet},
+				Pods:            common.PodInfo{Running: 1, Warnings: []common.Event{}},
+				PodTemplateHash: "1234567890",
+				Revision:        "3",
+			},
+		},
 	}
 
 	for _, c := range cases {
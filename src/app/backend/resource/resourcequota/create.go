@@ -0,0 +1,82 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"log"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ResourceQuotaSpec is a specification of a resource quota to create in a namespace. Empty
+// limits are left out of the quota.
+type ResourceQuotaSpec struct {
+	// Name of the resource quota.
+	Name string `json:"name"`
+
+	// CPULimit is the hard limit of CPU requested by the namespace, e.g. "2" or "500m".
+	CPULimit string `json:"cpuLimit,omitempty"`
+
+	// MemoryLimit is the hard limit of memory requested by the namespace, e.g. "4Gi".
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// PodLimit is the hard limit of the number of pods in the namespace.
+	PodLimit string `json:"podLimit,omitempty"`
+}
+
+// CreateResourceQuota creates a resource quota with the hard limits of the given
+// specification in the given namespace.
+func CreateResourceQuota(spec *ResourceQuotaSpec, namespace string,
+	client kubernetes.Interface) error {
+	log.Printf("Creating resource quota %s in namespace %s", spec.Name, namespace)
+
+	hard, err := toHardResourceList(spec)
+	if err != nil {
+		return err
+	}
+
+	resourceQuota := &v1.ResourceQuota{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      spec.Name,
+			Namespace: namespace,
+		},
+		Spec: v1.ResourceQuotaSpec{Hard: hard},
+	}
+	_, err = client.CoreV1().ResourceQuotas(namespace).Create(resourceQuota)
+	return err
+}
+
+func toHardResourceList(spec *ResourceQuotaSpec) (v1.ResourceList, error) {
+	hard := v1.ResourceList{}
+	limits := map[v1.ResourceName]string{
+		v1.ResourceCPU:    spec.CPULimit,
+		v1.ResourceMemory: spec.MemoryLimit,
+		v1.ResourcePods:   spec.PodLimit,
+	}
+	for name, limit := range limits {
+		if limit == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(limit)
+		if err != nil {
+			return nil, err
+		}
+		hard[name] = quantity
+	}
+	return hard, nil
+}
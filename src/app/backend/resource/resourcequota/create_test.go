@@ -0,0 +1,61 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcequota
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateResourceQuota(t *testing.T) {
+	spec := &ResourceQuotaSpec{
+		Name:        "compute-quota",
+		CPULimit:    "2",
+		MemoryLimit: "4Gi",
+		PodLimit:    "10",
+	}
+	fakeClient := fake.NewSimpleClientset()
+
+	if err := CreateResourceQuota(spec, "ns-1", fakeClient); err != nil {
+		t.Fatalf("CreateResourceQuota(%#v, ns-1) returned error: %s", spec, err)
+	}
+
+	quota, err := fakeClient.CoreV1().ResourceQuotas("ns-1").Get("compute-quota",
+		metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("CreateResourceQuota(%#v, ns-1) did not create the quota: %s", spec, err)
+	}
+
+	expectedHard := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("2"),
+		v1.ResourceMemory: resource.MustParse("4Gi"),
+		v1.ResourcePods:   resource.MustParse("10"),
+	}
+	for name, expected := range expectedHard {
+		if actual, found := quota.Spec.Hard[name]; !found || actual.Cmp(expected) != 0 {
+			t.Errorf("CreateResourceQuota(%#v, ns-1) set hard limit %s to %s, expected %s",
+				spec, name, actual.String(), expected.String())
+		}
+	}
+
+	invalidSpec := &ResourceQuotaSpec{Name: "broken-quota", CPULimit: "two"}
+	if err := CreateResourceQuota(invalidSpec, "ns-1", fakeClient); err == nil {
+		t.Errorf("CreateResourceQuota(%#v, ns-1) accepted invalid quantity", invalidSpec)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+// SecretCertificate describes the certificate stored in a single kubernetes.io/tls secret,
+// used by the certificate hygiene view.
+type SecretCertificate struct {
+	// Name of the secret the certificate was read from.
+	SecretName string `json:"secretName"`
+
+	// Issuer of the certificate.
+	Issuer string `json:"issuer"`
+
+	// Subject alternative names the certificate is valid for.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames"`
+
+	// Time the certificate stops being valid.
+	NotAfter metaV1.Time `json:"notAfter"`
+
+	// Number of full days until the certificate expires, negative when already expired.
+	DaysUntilExpiry int `json:"daysUntilExpiry"`
+}
+
+// SecretCertificateList contains the certificates of all kubernetes.io/tls secrets of a
+// namespace, sorted by soonest expiry.
+type SecretCertificateList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// List of certificates.
+	Certificates []SecretCertificate `json:"certificates"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetSecretCertificateList scans all kubernetes.io/tls secrets of the given namespace and
+// returns their certificates sorted by soonest expiry. Secrets whose certificate cannot be
+// parsed are skipped.
+func GetSecretCertificateList(client kubernetes.Interface, namespace string) (
+	*SecretCertificateList, error) {
+	log.Printf("Getting certificates of secrets in %s namespace", namespace)
+
+	secretList, err := client.CoreV1().Secrets(namespace).List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toSecretCertificateList(secretList.Items, nonCriticalErrors, time.Now()), nil
+}
+
+func toSecretCertificateList(secrets []v1.Secret, nonCriticalErrors []error,
+	now time.Time) *SecretCertificateList {
+	result := &SecretCertificateList{
+		Certificates: make([]SecretCertificate, 0),
+		Errors:       nonCriticalErrors,
+	}
+
+	for _, secret := range secrets {
+		if secret.Type != v1.SecretTypeTLS {
+			continue
+		}
+
+		certificate, err := parseCertificate(secret.Data[v1.TLSCertKey])
+		if err != nil {
+			log.Printf("Skipping certificate of %s secret: %s", secret.Name, err)
+			continue
+		}
+
+		result.Certificates = append(result.Certificates, SecretCertificate{
+			SecretName:              secret.Name,
+			Issuer:                  certificate.Issuer.CommonName,
+			SubjectAlternativeNames: getSubjectAlternativeNames(certificate),
+			NotAfter:                metaV1.NewTime(certificate.NotAfter),
+			DaysUntilExpiry:         int(certificate.NotAfter.Sub(now).Hours() / 24),
+		})
+	}
+
+	sort.Slice(result.Certificates, func(i, j int) bool {
+		return result.Certificates[i].NotAfter.Before(&result.Certificates[j].NotAfter)
+	})
+	result.ListMeta = api.ListMeta{TotalItems: len(result.Certificates)}
+	return result
+}
+
+// parseCertificate returns the leaf certificate of the PEM encoded certificate chain.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("Secret does not contain a PEM encoded certificate.")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func getSubjectAlternativeNames(certificate *x509.Certificate) []string {
+	names := make([]string, 0)
+	names = append(names, certificate.DNSNames...)
+	for _, ip := range certificate.IPAddresses {
+		names = append(names, ip.String())
+	}
+	return names
+}
@@ -0,0 +1,111 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createTLSSecret returns a kubernetes.io/tls secret holding a self-signed certificate with
+// the given validity and DNS names.
+func createTLSSecret(t *testing.T, name string, notAfter time.Time, dnsNames []string) v1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate key for %s secret: %s", name, err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-issuer"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     dnsNames,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey,
+		key)
+	if err != nil {
+		t.Fatalf("Could not create certificate for %s secret: %s", name, err)
+	}
+
+	return v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns-1"},
+		Type:       v1.SecretTypeTLS,
+		Data: map[string][]byte{
+			v1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}),
+		},
+	}
+}
+
+func TestToSecretCertificateList(t *testing.T) {
+	now := time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC)
+	nearExpiry := now.Add(10 * 24 * time.Hour)
+	longLived := now.Add(365 * 24 * time.Hour)
+
+	// The long-lived certificate comes first to verify sorting by soonest expiry.
+	secrets := []v1.Secret{
+		createTLSSecret(t, "long-lived-cert", longLived, []string{"example.com"}),
+		createTLSSecret(t, "near-expiry-cert", nearExpiry, []string{"api.example.com", "www.example.com"}),
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "broken-cert", Namespace: "ns-1"},
+			Type:       v1.SecretTypeTLS,
+			Data:       map[string][]byte{v1.TLSCertKey: []byte("not a certificate")},
+		},
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "opaque-secret", Namespace: "ns-1"},
+			Type:       v1.SecretTypeOpaque,
+		},
+	}
+
+	expected := &SecretCertificateList{
+		ListMeta: api.ListMeta{TotalItems: 2},
+		Certificates: []SecretCertificate{
+			{
+				SecretName:              "near-expiry-cert",
+				Issuer:                  "test-issuer",
+				SubjectAlternativeNames: []string{"api.example.com", "www.example.com"},
+				NotAfter:                metaV1.NewTime(nearExpiry),
+				DaysUntilExpiry:         10,
+			},
+			{
+				SecretName:              "long-lived-cert",
+				Issuer:                  "test-issuer",
+				SubjectAlternativeNames: []string{"example.com"},
+				NotAfter:                metaV1.NewTime(longLived),
+				DaysUntilExpiry:         365,
+			},
+		},
+		Errors: []error{},
+	}
+
+	actual := toSecretCertificateList(secrets, []error{}, now)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toSecretCertificateList(secrets, errors, now) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetSecretDiff(t *testing.T) {
+	stored := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "secret-1", Namespace: "ns-1"},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("old-password"),
+			"token":    []byte("old-token"),
+		},
+	}
+	proposed := &v1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "secret-1", Namespace: "ns-1"},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("new-password"),
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(stored)
+
+	actual, err := GetSecretDiff(fakeClient, "ns-1", "secret-1", proposed)
+	if err != nil {
+		t.Fatalf("GetSecretDiff(client, ns-1, secret-1, proposed) returned error: %s", err)
+	}
+
+	expected := &common.KeyDiff{
+		AddedKeys:   []string{},
+		ChangedKeys: []string{"password"},
+		RemovedKeys: []string{"token"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetSecretDiff(client, ns-1, secret-1, proposed) == \ngot %#v, \nexpected %#v",
+			actual, expected)
+	}
+}
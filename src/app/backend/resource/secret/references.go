@@ -0,0 +1,147 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SecretReferences lists every object that references a secret, so that the blast radius of a
+// leaked or rotated secret can be assessed. Secrets cannot be referenced across namespaces, so
+// all referencing objects live in the namespace of the secret.
+type SecretReferences struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Names of pods referencing the secret through env, a volume or imagePullSecrets.
+	Pods []string `json:"pods"`
+
+	// Names of deployments whose pod template references the secret.
+	Deployments []string `json:"deployments"`
+
+	// Names of stateful sets whose pod template references the secret.
+	StatefulSets []string `json:"statefulSets"`
+
+	// Names of service accounts holding the secret as a token or image pull secret.
+	ServiceAccounts []string `json:"serviceAccounts"`
+}
+
+// GetSecretReferences returns the pods, deployments, stateful sets and service accounts that
+// reference the given secret. Workloads are listed once and cross-referenced in memory.
+func GetSecretReferences(client kubernetes.Interface, namespace,
+	name string) (*SecretReferences, error) {
+	log.Printf("Getting references of %s secret in %s namespace", name, namespace)
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nsQuery := common.NewSameNamespaceQuery(namespace)
+	channels := &common.ResourceChannels{
+		PodList:         common.GetPodListChannel(client, nsQuery, 1),
+		DeploymentList:  common.GetDeploymentListChannel(client, nsQuery, 1),
+		StatefulSetList: common.GetStatefulSetListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+	deployments := <-channels.DeploymentList.List
+	if err := <-channels.DeploymentList.Error; err != nil {
+		return nil, err
+	}
+	statefulSets := <-channels.StatefulSetList.List
+	if err := <-channels.StatefulSetList.Error; err != nil {
+		return nil, err
+	}
+	serviceAccounts, err := client.CoreV1().ServiceAccounts(namespace).List(metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	references := &SecretReferences{
+		ObjectMeta:      api.NewObjectMeta(secret.ObjectMeta),
+		TypeMeta:        api.NewTypeMeta(api.ResourceKindSecret),
+		Pods:            make([]string, 0),
+		Deployments:     make([]string, 0),
+		StatefulSets:    make([]string, 0),
+		ServiceAccounts: make([]string, 0),
+	}
+	for _, pod := range pods.Items {
+		if podSpecUsesSecret(&pod.Spec, name) {
+			references.Pods = append(references.Pods, pod.Name)
+		}
+	}
+	for _, deployment := range deployments.Items {
+		if podSpecUsesSecret(&deployment.Spec.Template.Spec, name) {
+			references.Deployments = append(references.Deployments, deployment.Name)
+		}
+	}
+	for _, statefulSet := range statefulSets.Items {
+		if podSpecUsesSecret(&statefulSet.Spec.Template.Spec, name) {
+			references.StatefulSets = append(references.StatefulSets, statefulSet.Name)
+		}
+	}
+	for _, serviceAccount := range serviceAccounts.Items {
+		if serviceAccountReferencesSecret(&serviceAccount, name) {
+			references.ServiceAccounts = append(references.ServiceAccounts, serviceAccount.Name)
+		}
+	}
+	sort.Strings(references.Pods)
+	sort.Strings(references.Deployments)
+	sort.Strings(references.StatefulSets)
+	sort.Strings(references.ServiceAccounts)
+
+	return references, nil
+}
+
+// podSpecUsesSecret checks whether the pod spec references the secret through a volume, env of
+// any of its containers or imagePullSecrets.
+func podSpecUsesSecret(spec *v1.PodSpec, name string) bool {
+	if podSpecReferencesSecret(spec, name) {
+		return true
+	}
+	for _, pullSecret := range spec.ImagePullSecrets {
+		if pullSecret.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceAccountReferencesSecret checks whether the service account holds the secret as a token
+// secret or an image pull secret.
+func serviceAccountReferencesSecret(serviceAccount *v1.ServiceAccount, name string) bool {
+	for _, tokenSecret := range serviceAccount.Secrets {
+		if tokenSecret.Name == name {
+			return true
+		}
+	}
+	for _, pullSecret := range serviceAccount.ImagePullSecrets {
+		if pullSecret.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetSecretReferences(t *testing.T) {
+	namespace := "ns-1"
+	secret := &v1.Secret{ObjectMeta: metaV1.ObjectMeta{
+		Name: "referenced-secret", Namespace: namespace,
+	}}
+	podWithVolume := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-with-volume", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{{
+				Name: "secret",
+				VolumeSource: v1.VolumeSource{
+					Secret: &v1.SecretVolumeSource{SecretName: "referenced-secret"},
+				},
+			}},
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+	unrelatedPod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unrelated-pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+	statefulSetWithPullSecret := &apps.StatefulSet{
+		ObjectMeta: metaV1.ObjectMeta{Name: "statefulset-with-pull-secret", Namespace: namespace},
+		Spec: apps.StatefulSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					ImagePullSecrets: []v1.LocalObjectReference{{Name: "referenced-secret"}},
+				},
+			},
+		},
+	}
+	serviceAccountWithToken := &v1.ServiceAccount{
+		ObjectMeta: metaV1.ObjectMeta{Name: "sa-with-token", Namespace: namespace},
+		Secrets:    []v1.ObjectReference{{Name: "referenced-secret"}},
+	}
+	unrelatedServiceAccount := &v1.ServiceAccount{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unrelated-sa", Namespace: namespace},
+	}
+
+	fakeClient := fake.NewSimpleClientset(secret, podWithVolume, unrelatedPod,
+		statefulSetWithPullSecret, serviceAccountWithToken, unrelatedServiceAccount)
+
+	references, err := GetSecretReferences(fakeClient, namespace, "referenced-secret")
+	if err != nil {
+		t.Fatalf("GetSecretReferences(client, %s, referenced-secret) returned error: %s",
+			namespace, err)
+	}
+
+	expectedPods := []string{"pod-with-volume"}
+	if !reflect.DeepEqual(references.Pods, expectedPods) {
+		t.Errorf("Expected pods referencing secret to be %#v but got %#v", expectedPods,
+			references.Pods)
+	}
+	if len(references.Deployments) != 0 {
+		t.Errorf("Expected no deployments referencing secret but got %#v",
+			references.Deployments)
+	}
+	expectedStatefulSets := []string{"statefulset-with-pull-secret"}
+	if !reflect.DeepEqual(references.StatefulSets, expectedStatefulSets) {
+		t.Errorf("Expected stateful sets referencing secret to be %#v but got %#v",
+			expectedStatefulSets, references.StatefulSets)
+	}
+	expectedServiceAccounts := []string{"sa-with-token"}
+	if !reflect.DeepEqual(references.ServiceAccounts, expectedServiceAccounts) {
+		t.Errorf("Expected service accounts referencing secret to be %#v but got %#v",
+			expectedServiceAccounts, references.ServiceAccounts)
+	}
+}
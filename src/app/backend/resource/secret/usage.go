@@ -0,0 +1,127 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SecretUsage lists the objects in a namespace that reference a secret, so that users can
+// check whether it is still in use before deleting it.
+type SecretUsage struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Names of pods in the namespace that reference the secret through envFrom, valueFrom
+	// or a volume.
+	Pods []string `json:"pods"`
+
+	// Names of deployments in the namespace whose pod template references the secret.
+	Deployments []string `json:"deployments"`
+
+	// True when at least one object references the secret.
+	InUse bool `json:"inUse"`
+}
+
+// GetSecretUsage returns the pods and deployments in the given namespace that reference the
+// given secret.
+func GetSecretUsage(client kubernetes.Interface, namespace, name string) (*SecretUsage, error) {
+	log.Printf("Getting usage of %s secret in %s namespace", name, namespace)
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nsQuery := common.NewSameNamespaceQuery(namespace)
+	channels := &common.ResourceChannels{
+		PodList:        common.GetPodListChannel(client, nsQuery, 1),
+		DeploymentList: common.GetDeploymentListChannel(client, nsQuery, 1),
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+	deployments := <-channels.DeploymentList.List
+	if err := <-channels.DeploymentList.Error; err != nil {
+		return nil, err
+	}
+
+	usage := &SecretUsage{
+		ObjectMeta:  api.NewObjectMeta(secret.ObjectMeta),
+		TypeMeta:    api.NewTypeMeta(api.ResourceKindSecret),
+		Pods:        make([]string, 0),
+		Deployments: make([]string, 0),
+	}
+	for _, pod := range pods.Items {
+		if podSpecReferencesSecret(&pod.Spec, name) {
+			usage.Pods = append(usage.Pods, pod.Name)
+		}
+	}
+	for _, deployment := range deployments.Items {
+		if podSpecReferencesSecret(&deployment.Spec.Template.Spec, name) {
+			usage.Deployments = append(usage.Deployments, deployment.Name)
+		}
+	}
+	sort.Strings(usage.Pods)
+	sort.Strings(usage.Deployments)
+	usage.InUse = len(usage.Pods) > 0 || len(usage.Deployments) > 0
+
+	return usage, nil
+}
+
+// podSpecReferencesSecret checks whether the pod spec references the secret through a volume,
+// envFrom or valueFrom of any of its containers.
+func podSpecReferencesSecret(spec *v1.PodSpec, name string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == name {
+			return true
+		}
+	}
+	for _, container := range spec.InitContainers {
+		if containerReferencesSecret(container, name) {
+			return true
+		}
+	}
+	for _, container := range spec.Containers {
+		if containerReferencesSecret(container, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containerReferencesSecret(container v1.Container, name string) bool {
+	for _, envFrom := range container.EnvFrom {
+		if envFrom.SecretRef != nil && envFrom.SecretRef.Name == name {
+			return true
+		}
+	}
+	for _, env := range container.Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil &&
+			env.ValueFrom.SecretKeyRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
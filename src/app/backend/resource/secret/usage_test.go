@@ -0,0 +1,119 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1beta2"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetSecretUsage(t *testing.T) {
+	namespace := "ns-1"
+	secret := &v1.Secret{ObjectMeta: metaV1.ObjectMeta{
+		Name: "used-secret", Namespace: namespace,
+	}}
+	podWithValueFrom := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-with-value-from", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "container-1",
+				Env: []v1.EnvVar{{
+					Name: "PASSWORD",
+					ValueFrom: &v1.EnvVarSource{
+						SecretKeyRef: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "used-secret"},
+							Key:                  "password",
+						},
+					},
+				}},
+			}},
+		},
+	}
+	deploymentWithVolume := &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "deployment-with-volume", Namespace: namespace},
+		Spec: apps.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{{
+						Name: "secret",
+						VolumeSource: v1.VolumeSource{
+							Secret: &v1.SecretVolumeSource{SecretName: "used-secret"},
+						},
+					}},
+				},
+			},
+		},
+	}
+	unrelatedPod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "unrelated-pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(secret, podWithValueFrom, deploymentWithVolume,
+		unrelatedPod)
+
+	usage, err := GetSecretUsage(fakeClient, namespace, "used-secret")
+	if err != nil {
+		t.Fatalf("GetSecretUsage(client, %s, used-secret) returned error: %s", namespace, err)
+	}
+
+	expectedPods := []string{"pod-with-value-from"}
+	if !reflect.DeepEqual(usage.Pods, expectedPods) {
+		t.Errorf("Expected pods using secret to be %#v but got %#v", expectedPods, usage.Pods)
+	}
+	expectedDeployments := []string{"deployment-with-volume"}
+	if !reflect.DeepEqual(usage.Deployments, expectedDeployments) {
+		t.Errorf("Expected deployments using secret to be %#v but got %#v", expectedDeployments,
+			usage.Deployments)
+	}
+	if !usage.InUse {
+		t.Error("Expected secret to be reported as in use")
+	}
+}
+
+func TestGetSecretUsageUnreferenced(t *testing.T) {
+	namespace := "ns-1"
+	secret := &v1.Secret{ObjectMeta: metaV1.ObjectMeta{
+		Name: "unused-secret", Namespace: namespace,
+	}}
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-1", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "container-1"}},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(secret, pod)
+
+	usage, err := GetSecretUsage(fakeClient, namespace, "unused-secret")
+	if err != nil {
+		t.Fatalf("GetSecretUsage(client, %s, unused-secret) returned error: %s", namespace, err)
+	}
+
+	if len(usage.Pods) != 0 || len(usage.Deployments) != 0 {
+		t.Errorf("Expected no objects using secret but got pods %#v and deployments %#v",
+			usage.Pods, usage.Deployments)
+	}
+	if usage.InUse {
+		t.Error("Expected secret to be reported as not in use")
+	}
+}
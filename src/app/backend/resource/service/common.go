@@ -40,18 +40,20 @@ func ToService(service *v1.Service) Service {
 func ToServiceDetail(service *v1.Service, events common.EventList, pods pod.PodList, endpointList endpoint.EndpointList,
 	nonCriticalErrors []error) ServiceDetail {
 	return ServiceDetail{
-		ObjectMeta:        api.NewObjectMeta(service.ObjectMeta),
-		TypeMeta:          api.NewTypeMeta(api.ResourceKindService),
-		InternalEndpoint:  common.GetInternalEndpoint(service.Name, service.Namespace, service.Spec.Ports),
-		ExternalEndpoints: common.GetExternalEndpoints(service),
-		EndpointList:      endpointList,
-		Selector:          service.Spec.Selector,
-		ClusterIP:         service.Spec.ClusterIP,
-		Type:              service.Spec.Type,
-		EventList:         events,
-		PodList:           pods,
-		SessionAffinity:   service.Spec.SessionAffinity,
-		Errors:            nonCriticalErrors,
+		ObjectMeta:            api.NewObjectMeta(service.ObjectMeta),
+		TypeMeta:              api.NewTypeMeta(api.ResourceKindService),
+		InternalEndpoint:      common.GetInternalEndpoint(service.Name, service.Namespace, service.Spec.Ports),
+		ExternalEndpoints:     common.GetExternalEndpoints(service),
+		EndpointList:          endpointList,
+		Selector:              service.Spec.Selector,
+		ClusterIP:             service.Spec.ClusterIP,
+		Type:                  service.Spec.Type,
+		EventList:             events,
+		PodList:               pods,
+		SessionAffinity:       service.Spec.SessionAffinity,
+		SessionAffinityConfig: service.Spec.SessionAffinityConfig,
+		ExternalTrafficPolicy: service.Spec.ExternalTrafficPolicy,
+		Errors:                nonCriticalErrors,
 	}
 }
 
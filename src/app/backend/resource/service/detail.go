@@ -64,6 +64,14 @@ type ServiceDetail struct {
 	// Show the value of the SessionAffinity of the Service.
 	SessionAffinity v1.ServiceAffinity `json:"sessionAffinity"`
 
+	// SessionAffinityConfig contains session affinity details, e.g. the ClientIP sticky
+	// session timeout.
+	SessionAffinityConfig *v1.SessionAffinityConfig `json:"sessionAffinityConfig"`
+
+	// ExternalTrafficPolicy denotes whether external traffic is routed to node-local or
+	// cluster-wide endpoints, which influences source IP preservation.
+	ExternalTrafficPolicy v1.ServiceExternalTrafficPolicyType `json:"externalTrafficPolicy"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -122,3 +122,39 @@ func TestGetServiceDetail(t *testing.T) {
 		}
 	}
 }
+
+func TestGetServiceDetailTrafficPolicy(t *testing.T) {
+	timeoutSeconds := int32(3600)
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "svc-3", Namespace: "ns-3"},
+		Spec: v1.ServiceSpec{
+			Type:                  v1.ServiceTypeLoadBalancer,
+			ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyTypeLocal,
+			SessionAffinity:       v1.ServiceAffinityClientIP,
+			SessionAffinityConfig: &v1.SessionAffinityConfig{
+				ClientIP: &v1.ClientIPConfig{TimeoutSeconds: &timeoutSeconds},
+			},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(service)
+	actual, err := GetServiceDetail(fakeClient, nil, "ns-3", "svc-3", dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetServiceDetail(client, nil, ns-3, svc-3) returned error: %s", err)
+	}
+
+	if actual.ExternalTrafficPolicy != v1.ServiceExternalTrafficPolicyTypeLocal {
+		t.Errorf("GetServiceDetail(client, nil, ns-3, svc-3) returned external traffic policy %s, expected %s",
+			actual.ExternalTrafficPolicy, v1.ServiceExternalTrafficPolicyTypeLocal)
+	}
+	if actual.SessionAffinity != v1.ServiceAffinityClientIP {
+		t.Errorf("GetServiceDetail(client, nil, ns-3, svc-3) returned session affinity %s, expected %s",
+			actual.SessionAffinity, v1.ServiceAffinityClientIP)
+	}
+	if actual.SessionAffinityConfig == nil || actual.SessionAffinityConfig.ClientIP == nil ||
+		actual.SessionAffinityConfig.ClientIP.TimeoutSeconds == nil ||
+		*actual.SessionAffinityConfig.ClientIP.TimeoutSeconds != timeoutSeconds {
+		t.Errorf("GetServiceDetail(client, nil, ns-3, svc-3) returned session affinity config %+v, expected ClientIP timeout %d",
+			actual.SessionAffinityConfig, timeoutSeconds)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/validation"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// ServiceTypeUpdateSpec is a specification of a service type change.
+type ServiceTypeUpdateSpec struct {
+	// Target service type. Valid options: ClusterIP, NodePort, LoadBalancer.
+	Type v1.ServiceType `json:"type"`
+}
+
+// UpdateServiceType changes the type of the service with the given name in the given namespace.
+// Moving to ClusterIP clears node ports so the apiserver accepts the update, while moving to
+// NodePort or LoadBalancer lets the apiserver allocate them. UDP services cannot be exposed
+// through a load balancer, matching the protocol validation used when deploying apps.
+func UpdateServiceType(client k8sClient.Interface, namespace, name string,
+	spec *ServiceTypeUpdateSpec) (*Service, error) {
+
+	log.Printf("Updating type of %s service in %s namespace to %s", name, namespace, spec.Type)
+
+	switch spec.Type {
+	case v1.ServiceTypeClusterIP, v1.ServiceTypeNodePort, v1.ServiceTypeLoadBalancer:
+	default:
+		return nil, fmt.Errorf("Unknown service type: %s", spec.Type)
+	}
+
+	service, err := client.CoreV1().Services(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Type == v1.ServiceTypeLoadBalancer {
+		for _, port := range service.Spec.Ports {
+			validity := validation.ValidateProtocol(&validation.ProtocolValiditySpec{
+				Protocol:   port.Protocol,
+				IsExternal: true,
+			})
+			if !validity.Valid {
+				return nil, fmt.Errorf("Cannot expose %s port %d through a load balancer",
+					port.Protocol, port.Port)
+			}
+		}
+	}
+
+	service.Spec.Type = spec.Type
+	if spec.Type == v1.ServiceTypeClusterIP {
+		for i := range service.Spec.Ports {
+			service.Spec.Ports[i].NodePort = 0
+		}
+	}
+
+	updated, err := client.CoreV1().Services(namespace).Update(service)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ToService(updated)
+	return &result, nil
+}
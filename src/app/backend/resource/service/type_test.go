@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateServiceType(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+		Spec: v1.ServiceSpec{
+			Type:  v1.ServiceTypeClusterIP,
+			Ports: []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80}},
+		},
+	}
+	client := fake.NewSimpleClientset(service)
+
+	result, err := UpdateServiceType(client, "ns-1", "svc-1",
+		&ServiceTypeUpdateSpec{Type: v1.ServiceTypeNodePort})
+
+	if err != nil {
+		t.Fatalf("UpdateServiceType(NodePort) returned error: %v", err)
+	}
+	if result.Type != v1.ServiceTypeNodePort {
+		t.Errorf("UpdateServiceType(NodePort) returned type %s, expected %s", result.Type,
+			v1.ServiceTypeNodePort)
+	}
+
+	updated, err := client.CoreV1().Services("ns-1").Get("svc-1", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Cannot get updated service: %v", err)
+	}
+	if updated.Spec.Type != v1.ServiceTypeNodePort {
+		t.Errorf("Updated service has type %s, expected %s", updated.Spec.Type,
+			v1.ServiceTypeNodePort)
+	}
+}
+
+func TestUpdateServiceTypeShouldRejectUDPLoadBalancer(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+		Spec: v1.ServiceSpec{
+			Type:  v1.ServiceTypeClusterIP,
+			Ports: []v1.ServicePort{{Protocol: v1.ProtocolUDP, Port: 53}},
+		},
+	}
+	client := fake.NewSimpleClientset(service)
+
+	_, err := UpdateServiceType(client, "ns-1", "svc-1",
+		&ServiceTypeUpdateSpec{Type: v1.ServiceTypeLoadBalancer})
+
+	if err == nil {
+		t.Error("UpdateServiceType(LoadBalancer) did not fail for a UDP service")
+	}
+}
+
+func TestUpdateServiceTypeShouldRejectUnknownType(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	_, err := UpdateServiceType(client, "ns-1", "svc-1",
+		&ServiceTypeUpdateSpec{Type: "ExternalName"})
+
+	if err == nil {
+		t.Error("UpdateServiceType(ExternalName) did not fail for an unsupported type")
+	}
+}
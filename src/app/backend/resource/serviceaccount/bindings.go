@@ -0,0 +1,74 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceaccount
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/rbacrolebindings"
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetServiceAccountBindings returns the RoleBindings and ClusterRoleBindings that have the
+// given service account as a subject, so its effective permissions can be audited.
+func GetServiceAccountBindings(client kubernetes.Interface, namespace,
+	name string) (*rbacrolebindings.RbacRoleBindingList, error) {
+	log.Printf("Getting bindings of %s service account in %s namespace", name, namespace)
+
+	roleBindingList, err := client.RbacV1().RoleBindings(namespace).List(api.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleBindingList, err := client.RbacV1().ClusterRoleBindings().List(api.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	roleBindings := make([]rbac.RoleBinding, 0)
+	for _, binding := range roleBindingList.Items {
+		if hasServiceAccountSubject(binding.Subjects, namespace, name, binding.Namespace) {
+			roleBindings = append(roleBindings, binding)
+		}
+	}
+
+	clusterRoleBindings := make([]rbac.ClusterRoleBinding, 0)
+	for _, binding := range clusterRoleBindingList.Items {
+		if hasServiceAccountSubject(binding.Subjects, namespace, name, "") {
+			clusterRoleBindings = append(clusterRoleBindings, binding)
+		}
+	}
+
+	return rbacrolebindings.SimplifyRbacRoleBindingLists(roleBindings, clusterRoleBindings,
+		dataselect.NoDataSelect), nil
+}
+
+// hasServiceAccountSubject tells whether one of the subjects is the given service account.
+// A subject without a namespace matches when the binding lives in the account's namespace.
+func hasServiceAccountSubject(subjects []rbac.Subject, namespace, name,
+	bindingNamespace string) bool {
+	for _, subject := range subjects {
+		if subject.Kind != rbac.ServiceAccountKind || subject.Name != name {
+			continue
+		}
+		if subject.Namespace == namespace ||
+			(subject.Namespace == "" && bindingNamespace == namespace) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceaccount
+
+import (
+	"testing"
+
+	rbac "k8s.io/api/rbac/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetServiceAccountBindings(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(
+		&rbac.RoleBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "grants-sa-1", Namespace: "ns-1"},
+			Subjects: []rbac.Subject{
+				{Kind: rbac.ServiceAccountKind, Name: "sa-1", Namespace: "ns-1"},
+			},
+			RoleRef: rbac.RoleRef{Kind: "Role", Name: "reader"},
+		},
+		&rbac.RoleBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "grants-other", Namespace: "ns-1"},
+			Subjects: []rbac.Subject{
+				{Kind: rbac.ServiceAccountKind, Name: "sa-2", Namespace: "ns-1"},
+			},
+			RoleRef: rbac.RoleRef{Kind: "Role", Name: "writer"},
+		},
+		&rbac.ClusterRoleBinding{
+			ObjectMeta: metaV1.ObjectMeta{Name: "grants-sa-1-cluster"},
+			Subjects: []rbac.Subject{
+				{Kind: rbac.ServiceAccountKind, Name: "sa-1", Namespace: "ns-1"},
+			},
+			RoleRef: rbac.RoleRef{Kind: "ClusterRole", Name: "view"},
+		},
+	)
+
+	actual, err := GetServiceAccountBindings(fakeClient, "ns-1", "sa-1")
+	if err != nil {
+		t.Fatalf("GetServiceAccountBindings(client, ns-1, sa-1) returned error: %s", err)
+	}
+
+	if len(actual.Items) != 2 {
+		t.Fatalf("GetServiceAccountBindings(client, ns-1, sa-1) returned %d bindings, expected 2",
+			len(actual.Items))
+	}
+	if actual.Items[0].Name != "grants-sa-1" {
+		t.Errorf("GetServiceAccountBindings(client, ns-1, sa-1) first binding is %s, expected grants-sa-1",
+			actual.Items[0].Name)
+	}
+	if actual.Items[1].Name != "grants-sa-1-cluster" {
+		t.Errorf("GetServiceAccountBindings(client, ns-1, sa-1) second binding is %s, expected grants-sa-1-cluster",
+			actual.Items[1].Name)
+	}
+}
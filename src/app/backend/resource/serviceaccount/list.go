@@ -0,0 +1,104 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceaccount
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ServiceAccount is a presentation layer view of Kubernetes ServiceAccount resource with the
+// secrets it references, so access audits do not need the full object.
+type ServiceAccount struct {
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+	TypeMeta   api.TypeMeta   `json:"typeMeta"`
+
+	// Names of the secrets the service account references.
+	Secrets []string `json:"secrets"`
+
+	// Names of the image pull secrets the service account references.
+	ImagePullSecrets []string `json:"imagePullSecrets"`
+}
+
+// ServiceAccountList contains a list of Service Accounts in the cluster.
+type ServiceAccountList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Unordered list of Service Accounts.
+	Items []ServiceAccount `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetServiceAccountList returns all service accounts in the given namespace.
+func GetServiceAccountList(client kubernetes.Interface, nsQuery *common.NamespaceQuery,
+	dsQuery *dataselect.DataSelectQuery) (*ServiceAccountList, error) {
+	log.Printf("Getting list of service accounts in the namespace %s", nsQuery.ToRequestParam())
+
+	serviceAccountList, err := client.CoreV1().ServiceAccounts(nsQuery.ToRequestParam()).
+		List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toServiceAccountList(serviceAccountList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toServiceAccount(serviceAccount *v1.ServiceAccount) ServiceAccount {
+	secrets := make([]string, 0)
+	for _, secret := range serviceAccount.Secrets {
+		secrets = append(secrets, secret.Name)
+	}
+
+	imagePullSecrets := make([]string, 0)
+	for _, secret := range serviceAccount.ImagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, secret.Name)
+	}
+
+	return ServiceAccount{
+		ObjectMeta:       api.NewObjectMeta(serviceAccount.ObjectMeta),
+		TypeMeta:         api.NewTypeMeta(api.ResourceKindServiceAccount),
+		Secrets:          secrets,
+		ImagePullSecrets: imagePullSecrets,
+	}
+}
+
+func toServiceAccountList(serviceAccounts []v1.ServiceAccount, nonCriticalErrors []error,
+	dsQuery *dataselect.DataSelectQuery) *ServiceAccountList {
+	result := &ServiceAccountList{
+		Items:    make([]ServiceAccount, 0),
+		ListMeta: api.ListMeta{TotalItems: len(serviceAccounts)},
+		Errors:   nonCriticalErrors,
+	}
+
+	serviceAccountCells, filteredTotal := dataselect.GenericDataSelectWithFilter(
+		toCells(serviceAccounts), dsQuery)
+	serviceAccounts = fromCells(serviceAccountCells)
+	result.ListMeta = api.ListMeta{TotalItems: filteredTotal}
+
+	for i := range serviceAccounts {
+		result.Items = append(result.Items, toServiceAccount(&serviceAccounts[i]))
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceaccount
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToServiceAccountList(t *testing.T) {
+	serviceAccounts := []v1.ServiceAccount{
+		{
+			ObjectMeta: metaV1.ObjectMeta{Name: "sa-1", Namespace: "ns-1"},
+			Secrets: []v1.ObjectReference{
+				{Name: "sa-1-token-abcde"},
+			},
+			ImagePullSecrets: []v1.LocalObjectReference{
+				{Name: "registry-credentials"},
+			},
+		},
+	}
+
+	expected := &ServiceAccountList{
+		ListMeta: api.ListMeta{TotalItems: 1},
+		Errors:   []error{},
+		Items: []ServiceAccount{
+			{
+				ObjectMeta:       api.ObjectMeta{Name: "sa-1", Namespace: "ns-1"},
+				TypeMeta:         api.TypeMeta{Kind: api.ResourceKindServiceAccount},
+				Secrets:          []string{"sa-1-token-abcde"},
+				ImagePullSecrets: []string{"registry-credentials"},
+			},
+		},
+	}
+
+	actual := toServiceAccountList(serviceAccounts, []error{}, dataselect.NoDataSelect)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toServiceAccountList(%#v) == \ngot %#v, \nexpected %#v", serviceAccounts,
+			actual, expected)
+	}
+}
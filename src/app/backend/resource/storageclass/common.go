@@ -21,15 +21,29 @@ import (
 	storage "k8s.io/api/storage/v1"
 )
 
-func toStorageClass(storageClass *storage.StorageClass) StorageClass {
+// Annotations that mark a storage class as the cluster default.
+const (
+	isDefaultClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	isDefaultClassBetaAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+func toStorageClass(storageClass *storage.StorageClass, claimCount int) StorageClass {
 	return StorageClass{
 		ObjectMeta:  api.NewObjectMeta(storageClass.ObjectMeta),
 		TypeMeta:    api.NewTypeMeta(api.ResourceKindStorageClass),
 		Provisioner: storageClass.Provisioner,
 		Parameters:  storageClass.Parameters,
+		IsDefault:   isDefaultStorageClass(storageClass),
+		ClaimCount:  claimCount,
 	}
 }
 
+// isDefaultStorageClass checks whether the class is marked as the cluster default.
+func isDefaultStorageClass(storageClass *storage.StorageClass) bool {
+	return storageClass.Annotations[isDefaultClassAnnotation] == "true" ||
+		storageClass.Annotations[isDefaultClassBetaAnnotation] == "true"
+}
+
 func toStorageClassDetail(storageClass *storage.StorageClass,
 	persistentVolumeList *persistentvolume.PersistentVolumeList) StorageClassDetail {
 	return StorageClassDetail{
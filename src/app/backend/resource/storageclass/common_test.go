@@ -45,7 +45,7 @@ func TestToStorageClass(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		actual := toStorageClass(c.storage)
+		actual := toStorageClass(c.storage, 0)
 
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("toStorageClass(%#v) == \ngot %#v, \nexpected %#v", c.storage, actual, c.expected)
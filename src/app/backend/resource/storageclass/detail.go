@@ -42,6 +42,13 @@ type StorageClass struct {
 	// 512, with a cumulative max size of 256K
 	// +optional
 	Parameters map[string]string `json:"parameters"`
+
+	// isDefault is true when the class is marked as the cluster default via the
+	// storageclass.kubernetes.io/is-default-class annotation.
+	IsDefault bool `json:"isDefault"`
+
+	// claimCount is the number of persistent volume claims using this class.
+	ClaimCount int `json:"claimCount"`
 }
 
 // StorageClassDetail provides the presentation layer view of Kubernetes StorageClass resource,
@@ -21,6 +21,7 @@ import (
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
 	storage "k8s.io/api/storage/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -41,12 +42,15 @@ func GetStorageClassList(client kubernetes.Interface, dsQuery *dataselect.DataSe
 
 	channels := &common.ResourceChannels{
 		StorageClassList: common.GetStorageClassListChannel(client, 1),
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client,
+			common.NewNamespaceQuery([]string{}), 1),
 	}
 
 	return GetStorageClassListFromChannels(channels, dsQuery)
 }
 
 // GetStorageClassListFromChannels returns a list of all storage class objects in the cluster.
+// When a persistent volume claim channel is provided, claims using each class are counted.
 func GetStorageClassListFromChannels(channels *common.ResourceChannels,
 	dsQuery *dataselect.DataSelectQuery) (*StorageClassList, error) {
 	storageClasses := <-channels.StorageClassList.List
@@ -56,11 +60,33 @@ func GetStorageClassListFromChannels(channels *common.ResourceChannels,
 		return nil, criticalError
 	}
 
-	return toStorageClassList(storageClasses.Items, nonCriticalErrors, dsQuery), nil
+	claimCounts := map[string]int{}
+	if channels.PersistentVolumeClaimList.List != nil {
+		persistentVolumeClaims := <-channels.PersistentVolumeClaimList.List
+		err = <-channels.PersistentVolumeClaimList.Error
+		nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+		if criticalError != nil {
+			return nil, criticalError
+		}
+		claimCounts = getClaimCounts(persistentVolumeClaims.Items)
+	}
+
+	return toStorageClassList(storageClasses.Items, claimCounts, nonCriticalErrors, dsQuery), nil
+}
+
+// getClaimCounts aggregates the number of claims per storage class name.
+func getClaimCounts(claims []v1.PersistentVolumeClaim) map[string]int {
+	claimCounts := map[string]int{}
+	for _, claim := range claims {
+		if claim.Spec.StorageClassName != nil {
+			claimCounts[*claim.Spec.StorageClassName]++
+		}
+	}
+	return claimCounts
 }
 
-func toStorageClassList(storageClasses []storage.StorageClass, nonCriticalErrors []error,
-	dsQuery *dataselect.DataSelectQuery) *StorageClassList {
+func toStorageClassList(storageClasses []storage.StorageClass, claimCounts map[string]int,
+	nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *StorageClassList {
 
 	storageClassList := &StorageClassList{
 		StorageClasses: make([]StorageClass, 0),
@@ -73,7 +99,8 @@ func toStorageClassList(storageClasses []storage.StorageClass, nonCriticalErrors
 	storageClassList.ListMeta = api.ListMeta{TotalItems: filteredTotal}
 
 	for _, storageClass := range storageClasses {
-		storageClassList.StorageClasses = append(storageClassList.StorageClasses, toStorageClass(&storageClass))
+		storageClassList.StorageClasses = append(storageClassList.StorageClasses,
+			toStorageClass(&storageClass, claimCounts[storageClass.Name]))
 	}
 
 	return storageClassList
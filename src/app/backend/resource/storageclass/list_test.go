@@ -20,16 +20,19 @@ import (
 
 	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/dataselect"
+	"k8s.io/api/core/v1"
 	storage "k8s.io/api/storage/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetStorageClassList(t *testing.T) {
+	defaultClassName := "storage-default"
 	cases := []struct {
-		storageClassList *storage.StorageClassList
-		expectedActions  []string
-		expected         *StorageClassList
+		storageClassList          *storage.StorageClassList
+		persistentVolumeClaimList *v1.PersistentVolumeClaimList
+		expectedActions           []string
+		expected                  *StorageClassList
 	}{
 		{
 			storageClassList: &storage.StorageClassList{
@@ -41,7 +44,8 @@ func TestGetStorageClassList(t *testing.T) {
 						},
 					},
 				}},
-			expectedActions: []string{"list"},
+			persistentVolumeClaimList: &v1.PersistentVolumeClaimList{},
+			expectedActions:           []string{"list", "list"},
 			expected: &StorageClassList{
 				ListMeta: api.ListMeta{TotalItems: 1},
 				StorageClasses: []StorageClass{
@@ -56,10 +60,68 @@ func TestGetStorageClassList(t *testing.T) {
 				Errors: []error{},
 			},
 		},
+		{
+			storageClassList: &storage.StorageClassList{
+				Items: []storage.StorageClass{
+					{
+						ObjectMeta: metaV1.ObjectMeta{
+							Name:   "storage-1",
+							Labels: map[string]string{},
+						},
+					},
+					{
+						ObjectMeta: metaV1.ObjectMeta{
+							Name:   defaultClassName,
+							Labels: map[string]string{},
+							Annotations: map[string]string{
+								"storageclass.kubernetes.io/is-default-class": "true",
+							},
+						},
+					},
+				}},
+			persistentVolumeClaimList: &v1.PersistentVolumeClaimList{
+				Items: []v1.PersistentVolumeClaim{
+					{
+						ObjectMeta: metaV1.ObjectMeta{Name: "claim-1", Namespace: "default"},
+						Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &defaultClassName},
+					},
+					{
+						ObjectMeta: metaV1.ObjectMeta{Name: "claim-2", Namespace: "default"},
+						Spec:       v1.PersistentVolumeClaimSpec{StorageClassName: &defaultClassName},
+					},
+				},
+			},
+			expectedActions: []string{"list", "list"},
+			expected: &StorageClassList{
+				ListMeta: api.ListMeta{TotalItems: 2},
+				StorageClasses: []StorageClass{
+					{
+						ObjectMeta: api.ObjectMeta{
+							Name:   "storage-1",
+							Labels: map[string]string{},
+						},
+						TypeMeta: api.TypeMeta{Kind: api.ResourceKindStorageClass},
+					},
+					{
+						ObjectMeta: api.ObjectMeta{
+							Name:   defaultClassName,
+							Labels: map[string]string{},
+							Annotations: map[string]string{
+								"storageclass.kubernetes.io/is-default-class": "true",
+							},
+						},
+						TypeMeta:   api.TypeMeta{Kind: api.ResourceKindStorageClass},
+						IsDefault:  true,
+						ClaimCount: 2,
+					},
+				},
+				Errors: []error{},
+			},
+		},
 	}
 
 	for _, c := range cases {
-		fakeClient := fake.NewSimpleClientset(c.storageClassList)
+		fakeClient := fake.NewSimpleClientset(c.storageClassList, c.persistentVolumeClaimList)
 
 		actual, _ := GetStorageClassList(fakeClient, dataselect.NoDataSelect)
 
@@ -0,0 +1,187 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Types of admission webhook configurations a webhook can belong to.
+const (
+	MutatingWebhookType   = "Mutating"
+	ValidatingWebhookType = "Validating"
+)
+
+// AdmissionWebhook is a presentation layer view of a single webhook entry of a mutating or
+// validating webhook configuration. It shows admins what is intercepting their requests.
+type AdmissionWebhook struct {
+	// ObjectMeta of the configuration the webhook belongs to.
+	ObjectMeta api.ObjectMeta `json:"objectMeta"`
+
+	// Type of the configuration: Mutating or Validating.
+	Type string `json:"type"`
+
+	// Name of the webhook within the configuration.
+	Name string `json:"name"`
+
+	// FailurePolicy tells how errors from the webhook endpoint are handled.
+	FailurePolicy string `json:"failurePolicy"`
+
+	// TargetResources lists the resources the webhook intercepts, qualified with their API
+	// group when it is not the core one.
+	TargetResources []string `json:"targetResources"`
+
+	// Namespace and name of the service backing the webhook, empty for URL webhooks.
+	ServiceNamespace string `json:"serviceNamespace,omitempty"`
+	ServiceName      string `json:"serviceName,omitempty"`
+
+	// URL of the webhook, set for webhooks that do not use a service reference.
+	URL string `json:"url,omitempty"`
+
+	// ServiceUnavailable is true when the backing service does not exist or has no ready
+	// endpoints. Such webhooks are a common cause of cluster-wide request failures.
+	ServiceUnavailable bool `json:"serviceUnavailable"`
+}
+
+// AdmissionWebhookList contains all webhook entries of all mutating and validating webhook
+// configurations in the cluster.
+type AdmissionWebhookList struct {
+	ListMeta api.ListMeta `json:"listMeta"`
+
+	// Unordered list of admission webhooks.
+	Webhooks []AdmissionWebhook `json:"webhooks"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetAdmissionWebhookList returns all admission webhooks configured in the cluster.
+func GetAdmissionWebhookList(client kubernetes.Interface) (*AdmissionWebhookList, error) {
+	log.Print("Getting list of admission webhooks")
+
+	webhooks := make([]AdmissionWebhook, 0)
+
+	mutating, err := client.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().
+		List(api.ListEverything)
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	if mutating != nil {
+		for _, configuration := range mutating.Items {
+			for _, webhook := range configuration.Webhooks {
+				webhooks = append(webhooks, toAdmissionWebhook(client,
+					configuration.ObjectMeta, MutatingWebhookType, webhook))
+			}
+		}
+	}
+
+	validating, err := client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().
+		List(api.ListEverything)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	if validating != nil {
+		for _, configuration := range validating.Items {
+			for _, webhook := range configuration.Webhooks {
+				webhooks = append(webhooks, toAdmissionWebhook(client,
+					configuration.ObjectMeta, ValidatingWebhookType, webhook))
+			}
+		}
+	}
+
+	return &AdmissionWebhookList{
+		ListMeta: api.ListMeta{TotalItems: len(webhooks)},
+		Webhooks: webhooks,
+		Errors:   nonCriticalErrors,
+	}, nil
+}
+
+func toAdmissionWebhook(client kubernetes.Interface, configMeta metaV1.ObjectMeta,
+	webhookType string, webhook admissionregistration.Webhook) AdmissionWebhook {
+	result := AdmissionWebhook{
+		ObjectMeta:      api.NewObjectMeta(configMeta),
+		Type:            webhookType,
+		Name:            webhook.Name,
+		FailurePolicy:   string(admissionregistration.Ignore),
+		TargetResources: getTargetResources(webhook.Rules),
+	}
+
+	if webhook.FailurePolicy != nil {
+		result.FailurePolicy = string(*webhook.FailurePolicy)
+	}
+
+	if webhook.ClientConfig.URL != nil {
+		result.URL = *webhook.ClientConfig.URL
+		return result
+	}
+
+	service := webhook.ClientConfig.Service
+	if service != nil {
+		result.ServiceNamespace = service.Namespace
+		result.ServiceName = service.Name
+		result.ServiceUnavailable = !serviceHasReadyEndpoints(client, service.Namespace,
+			service.Name)
+	}
+
+	return result
+}
+
+// getTargetResources returns a sorted, deduplicated list of resources intercepted by given
+// rules, qualified with their API group when it is not the core one.
+func getTargetResources(rules []admissionregistration.RuleWithOperations) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0)
+	for _, rule := range rules {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				target := resource
+				if group != "" {
+					target = group + "/" + resource
+				}
+				if !seen[target] {
+					seen[target] = true
+					result = append(result, target)
+				}
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// serviceHasReadyEndpoints tells whether the service backing a webhook resolves to at least one
+// ready endpoint address.
+func serviceHasReadyEndpoints(client kubernetes.Interface, namespace, name string) bool {
+	endpoints, err := client.CoreV1().Endpoints(namespace).Get(name, metaV1.GetOptions{})
+	if err != nil {
+		return false
+	}
+
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
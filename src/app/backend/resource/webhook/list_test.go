@@ -0,0 +1,83 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetAdmissionWebhookListWithUnavailableService(t *testing.T) {
+	failurePolicy := admissionregistration.Fail
+	configuration := &admissionregistration.MutatingWebhookConfiguration{
+		ObjectMeta: metaV1.ObjectMeta{Name: "pod-mutator"},
+		Webhooks: []admissionregistration.Webhook{
+			{
+				Name:          "mutate.pods.example.com",
+				FailurePolicy: &failurePolicy,
+				Rules: []admissionregistration.RuleWithOperations{
+					{
+						Operations: []admissionregistration.OperationType{
+							admissionregistration.Create,
+						},
+						Rule: admissionregistration.Rule{
+							APIGroups:   []string{""},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"pods"},
+						},
+					},
+				},
+				ClientConfig: admissionregistration.WebhookClientConfig{
+					Service: &admissionregistration.ServiceReference{
+						Namespace: "ns-1", Name: "webhook-svc",
+					},
+				},
+			},
+		},
+	}
+
+	// No endpoints exist for webhook-svc, so the webhook must be flagged as unavailable.
+	fakeClient := fake.NewSimpleClientset(configuration)
+
+	actual, err := GetAdmissionWebhookList(fakeClient)
+	if err != nil {
+		t.Fatalf("GetAdmissionWebhookList(client) returned error: %s", err)
+	}
+
+	expected := &AdmissionWebhookList{
+		ListMeta: api.ListMeta{TotalItems: 1},
+		Webhooks: []AdmissionWebhook{
+			{
+				ObjectMeta:         api.NewObjectMeta(configuration.ObjectMeta),
+				Type:               MutatingWebhookType,
+				Name:               "mutate.pods.example.com",
+				FailurePolicy:      "Fail",
+				TargetResources:    []string{"pods"},
+				ServiceNamespace:   "ns-1",
+				ServiceName:        "webhook-svc",
+				ServiceUnavailable: true,
+			},
+		},
+		Errors: []error{},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetAdmissionWebhookList(client) == \ngot %#v, \nexpected %#v", actual, expected)
+	}
+}
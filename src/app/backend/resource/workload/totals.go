@@ -0,0 +1,117 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"github.com/kubernetes/dashboard/src/app/backend/api"
+	"github.com/kubernetes/dashboard/src/app/backend/errors"
+	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
+	"k8s.io/api/core/v1"
+)
+
+// ResourceTotals is a summary of the compute resources requested by the listed workloads.
+type ResourceTotals struct {
+	// Sum of CPU requests declared in workload pod templates, scaled by the desired replica
+	// count, in millicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// Sum of memory requests declared in workload pod templates, scaled by the desired
+	// replica count, in bytes.
+	MemoryRequests int64 `json:"memoryRequests"`
+}
+
+// getResourceTotals sums the CPU and memory requests declared in the pod templates of the
+// listed controllers. Only top level long-running controllers (deployments, stateful sets,
+// daemon sets and replication controllers) are counted, because including replica sets owned
+// by deployments or the pods themselves would count the same requests twice.
+func getResourceTotals(channels *common.ResourceChannels, kinds WorkloadKinds) (ResourceTotals, error) {
+	totals := ResourceTotals{}
+
+	if kinds.includes(api.ResourceKindDeployment) {
+		list := <-channels.DeploymentList.List
+		if criticalError := totalsError(<-channels.DeploymentList.Error); criticalError != nil {
+			return totals, criticalError
+		} else if list != nil {
+			for _, deployment := range list.Items {
+				totals.add(deployment.Spec.Template.Spec, desiredReplicas(deployment.Spec.Replicas))
+			}
+		}
+	}
+
+	if kinds.includes(api.ResourceKindStatefulSet) {
+		list := <-channels.StatefulSetList.List
+		if criticalError := totalsError(<-channels.StatefulSetList.Error); criticalError != nil {
+			return totals, criticalError
+		} else if list != nil {
+			for _, statefulSet := range list.Items {
+				totals.add(statefulSet.Spec.Template.Spec, desiredReplicas(statefulSet.Spec.Replicas))
+			}
+		}
+	}
+
+	if kinds.includes(api.ResourceKindDaemonSet) {
+		list := <-channels.DaemonSetList.List
+		if criticalError := totalsError(<-channels.DaemonSetList.Error); criticalError != nil {
+			return totals, criticalError
+		} else if list != nil {
+			for _, daemonSet := range list.Items {
+				totals.add(daemonSet.Spec.Template.Spec, daemonSet.Status.DesiredNumberScheduled)
+			}
+		}
+	}
+
+	if kinds.includes(api.ResourceKindReplicationController) {
+		list := <-channels.ReplicationControllerList.List
+		if criticalError := totalsError(<-channels.ReplicationControllerList.Error); criticalError != nil {
+			return totals, criticalError
+		} else if list != nil {
+			for _, rc := range list.Items {
+				if rc.Spec.Template != nil {
+					totals.add(rc.Spec.Template.Spec, desiredReplicas(rc.Spec.Replicas))
+				}
+			}
+		}
+	}
+
+	return totals, nil
+}
+
+// totalsError filters out non-critical channel errors, so that totals degrade gracefully for
+// kinds the user is not allowed to list.
+func totalsError(err error) error {
+	_, criticalError := errors.HandleError(err)
+	return criticalError
+}
+
+// desiredReplicas returns the desired replica count, defaulting to 1 when unset.
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// add accumulates the requests of all containers in the given pod spec, scaled by the desired
+// replica count.
+func (totals *ResourceTotals) add(spec v1.PodSpec, replicas int32) {
+	for _, container := range spec.Containers {
+		if cpu, found := container.Resources.Requests[v1.ResourceCPU]; found {
+			totals.CPURequests += cpu.MilliValue() * int64(replicas)
+		}
+		if memory, found := container.Resources.Requests[v1.ResourceMemory]; found {
+			totals.MemoryRequests += memory.Value() * int64(replicas)
+		}
+	}
+}
@@ -16,7 +16,9 @@ package workload
 
 import (
 	"log"
+	"strings"
 
+	"github.com/kubernetes/dashboard/src/app/backend/api"
 	"github.com/kubernetes/dashboard/src/app/backend/errors"
 	metricapi "github.com/kubernetes/dashboard/src/app/backend/integration/metric/api"
 	"github.com/kubernetes/dashboard/src/app/backend/resource/common"
@@ -43,37 +45,110 @@ type Workloads struct {
 	DaemonSetList             daemonset.DaemonSetList      `json:"daemonSetList"`
 	StatefulSetList           statefulset.StatefulSetList  `json:"statefulSetList"`
 
+	// Aggregate CPU and memory requests of the listed workloads.
+	ResourceTotals ResourceTotals `json:"resourceTotals"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
 
-// GetWorkloads returns a list of all workloads in the cluster.
+// WorkloadKinds is a set of workload controller kinds that should be included in the workloads
+// list. An empty (or nil) set means that all kinds are included.
+type WorkloadKinds map[string]bool
+
+// ParseWorkloadKinds parses a comma separated list of workload kind names (e.g.
+// "deployment,statefulset") into a WorkloadKinds set. Returns nil for an empty string, meaning
+// that no filtering should be applied.
+func ParseWorkloadKinds(raw string) WorkloadKinds {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	kinds := WorkloadKinds{}
+	for _, kind := range strings.Split(raw, ",") {
+		kinds[strings.ToLower(strings.TrimSpace(kind))] = true
+	}
+
+	return kinds
+}
+
+// includes returns whether the given kind should be included in the workloads list.
+func (kinds WorkloadKinds) includes(kind string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	return kinds[kind]
+}
+
+// GetWorkloads returns a list of all workloads in the cluster restricted to the given kinds
+// and, when labelSelector is not empty, to workloads matching it.
 func GetWorkloads(client kubernetes.Interface, metricClient metricapi.MetricClient,
-	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*Workloads, error) {
+	nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery,
+	kinds WorkloadKinds, labelSelector string) (*Workloads, error) {
 
 	log.Print("Getting lists of all workloads")
+
+	listOptions := api.ListEverything
+	listOptions.LabelSelector = labelSelector
+
+	// Channels shared between resource kinds are read once per kind that consumes them, so
+	// their read counts have to match the number of included consumers.
+	podConsumers := 0
+	for _, kind := range []string{api.ResourceKindReplicationController, api.ResourceKindReplicaSet,
+		api.ResourceKindJob, api.ResourceKindDeployment, api.ResourceKindPod,
+		api.ResourceKindDaemonSet, api.ResourceKindStatefulSet} {
+		if kinds.includes(kind) {
+			podConsumers++
+		}
+	}
+	replicaSetConsumers := 0
+	for _, kind := range []string{api.ResourceKindReplicaSet, api.ResourceKindDeployment} {
+		if kinds.includes(kind) {
+			replicaSetConsumers++
+		}
+	}
+
 	channels := &common.ResourceChannels{
-		ReplicationControllerList: common.GetReplicationControllerListChannel(client, nsQuery, 1),
-		ReplicaSetList:            common.GetReplicaSetListChannel(client, nsQuery, 2),
-		JobList:                   common.GetJobListChannel(client, nsQuery, 1),
-		CronJobList:               common.GetCronJobListChannel(client, nsQuery, 1),
-		DaemonSetList:             common.GetDaemonSetListChannel(client, nsQuery, 1),
-		DeploymentList:            common.GetDeploymentListChannel(client, nsQuery, 1),
-		StatefulSetList:           common.GetStatefulSetListChannel(client, nsQuery, 1),
-		ServiceList:               common.GetServiceListChannel(client, nsQuery, 1),
-		PodList:                   common.GetPodListChannel(client, nsQuery, 7),
-		EventList:                 common.GetEventListChannel(client, nsQuery, 7),
-	}
-
-	return GetWorkloadsFromChannels(channels, metricClient, dsQuery)
+		ServiceList: common.GetServiceListChannel(client, nsQuery, 1),
+	}
+	if podConsumers > 0 {
+		channels.PodList = common.GetPodListChannelWithOptions(client, nsQuery, listOptions, podConsumers)
+		channels.EventList = common.GetEventListChannel(client, nsQuery, podConsumers)
+	}
+	if replicaSetConsumers > 0 {
+		channels.ReplicaSetList = common.GetReplicaSetListChannelWithOptions(client, nsQuery,
+			listOptions, replicaSetConsumers)
+	}
+	// The controller list channels are read a second time by the resource totals summary.
+	if kinds.includes(api.ResourceKindReplicationController) {
+		channels.ReplicationControllerList = common.GetReplicationControllerListChannelWithOptions(
+			client, nsQuery, listOptions, 2)
+	}
+	if kinds.includes(api.ResourceKindJob) {
+		channels.JobList = common.GetJobListChannelWithOptions(client, nsQuery, listOptions, 1)
+	}
+	if kinds.includes(api.ResourceKindCronJob) {
+		channels.CronJobList = common.GetCronJobListChannelWithOptions(client, nsQuery, listOptions, 1)
+	}
+	if kinds.includes(api.ResourceKindDaemonSet) {
+		channels.DaemonSetList = common.GetDaemonSetListChannelWithOptions(client, nsQuery, listOptions, 2)
+	}
+	if kinds.includes(api.ResourceKindDeployment) {
+		channels.DeploymentList = common.GetDeploymentListChannelWithOptions(client, nsQuery, listOptions, 2)
+	}
+	if kinds.includes(api.ResourceKindStatefulSet) {
+		channels.StatefulSetList = common.GetStatefulSetListChannelWithOptions(client, nsQuery, listOptions, 2)
+	}
+
+	return GetWorkloadsFromChannels(channels, metricClient, dsQuery, kinds)
 }
 
 // GetWorkloadsFromChannels returns a list of all workloads in the cluster, from the channel sources.
 func GetWorkloadsFromChannels(channels *common.ResourceChannels, metricClient metricapi.MetricClient,
-	dsQuery *dataselect.DataSelectQuery) (*Workloads, error) {
+	dsQuery *dataselect.DataSelectQuery, kinds WorkloadKinds) (*Workloads, error) {
 
-	numErrs := 8
-	errChan := make(chan error, numErrs)
+	numErrs := 1
+	errChan := make(chan error, 9)
 	rsChan := make(chan *replicaset.ReplicaSetList)
 	jobChan := make(chan *job.JobList)
 	cjChan := make(chan *cronjob.CronJobList)
@@ -82,56 +157,87 @@ func GetWorkloadsFromChannels(channels *common.ResourceChannels, metricClient me
 	podChan := make(chan *pod.PodList)
 	dsChan := make(chan *daemonset.DaemonSetList)
 	ssChan := make(chan *statefulset.StatefulSetList)
+	totalsChan := make(chan ResourceTotals)
 
 	go func() {
-		rcList, err := rc.GetReplicationControllerListFromChannels(channels, dsQuery, nil)
+		totals, err := getResourceTotals(channels, kinds)
 		errChan <- err
-		rcChan <- rcList
+		totalsChan <- totals
 	}()
 
-	go func() {
-		rsList, err := replicaset.GetReplicaSetListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		rsChan <- rsList
-	}()
+	if kinds.includes(api.ResourceKindReplicationController) {
+		numErrs++
+		go func() {
+			rcList, err := rc.GetReplicationControllerListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			rcChan <- rcList
+		}()
+	}
 
-	go func() {
-		jobList, err := job.GetJobListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		jobChan <- jobList
-	}()
+	if kinds.includes(api.ResourceKindReplicaSet) {
+		numErrs++
+		go func() {
+			rsList, err := replicaset.GetReplicaSetListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			rsChan <- rsList
+		}()
+	}
 
-	go func() {
-		cronJobList, err := cronjob.GetCronJobListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		cjChan <- cronJobList
-	}()
+	if kinds.includes(api.ResourceKindJob) {
+		numErrs++
+		go func() {
+			jobList, err := job.GetJobListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			jobChan <- jobList
+		}()
+	}
 
-	go func() {
-		deploymentList, err := deployment.GetDeploymentListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		deploymentChan <- deploymentList
-	}()
+	if kinds.includes(api.ResourceKindCronJob) {
+		numErrs++
+		go func() {
+			cronJobList, err := cronjob.GetCronJobListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			cjChan <- cronJobList
+		}()
+	}
 
-	go func() {
-		podList, err := pod.GetPodListFromChannels(channels, dataselect.NewDataSelectQuery(
-			dsQuery.PaginationQuery, dsQuery.SortQuery, dsQuery.FilterQuery,
-			dataselect.StandardMetrics), metricClient)
-		errChan <- err
-		podChan <- podList
-	}()
+	if kinds.includes(api.ResourceKindDeployment) {
+		numErrs++
+		go func() {
+			deploymentList, err := deployment.GetDeploymentListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			deploymentChan <- deploymentList
+		}()
+	}
 
-	go func() {
-		dsList, err := daemonset.GetDaemonSetListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		dsChan <- dsList
-	}()
+	if kinds.includes(api.ResourceKindPod) {
+		numErrs++
+		go func() {
+			podList, err := pod.GetPodListFromChannels(channels, dataselect.NewDataSelectQuery(
+				dsQuery.PaginationQuery, dsQuery.SortQuery, dsQuery.FilterQuery,
+				dataselect.StandardMetrics), metricClient)
+			errChan <- err
+			podChan <- podList
+		}()
+	}
 
-	go func() {
-		ssList, err := statefulset.GetStatefulSetListFromChannels(channels, dsQuery, nil)
-		errChan <- err
-		ssChan <- ssList
-	}()
+	if kinds.includes(api.ResourceKindDaemonSet) {
+		numErrs++
+		go func() {
+			dsList, err := daemonset.GetDaemonSetListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			dsChan <- dsList
+		}()
+	}
+
+	if kinds.includes(api.ResourceKindStatefulSet) {
+		numErrs++
+		go func() {
+			ssList, err := statefulset.GetStatefulSetListFromChannels(channels, dsQuery, nil)
+			errChan <- err
+			ssChan <- ssList
+		}()
+	}
 
 	for i := 0; i < numErrs; i++ {
 		err := <-errChan
@@ -140,15 +246,30 @@ func GetWorkloadsFromChannels(channels *common.ResourceChannels, metricClient me
 		}
 	}
 
-	workloads := &Workloads{
-		ReplicaSetList:            *(<-rsChan),
-		JobList:                   *(<-jobChan),
-		CronJobList:               *(<-cjChan),
-		ReplicationControllerList: *(<-rcChan),
-		DeploymentList:            *(<-deploymentChan),
-		PodList:                   *(<-podChan),
-		DaemonSetList:             *(<-dsChan),
-		StatefulSetList:           *(<-ssChan),
+	workloads := &Workloads{ResourceTotals: <-totalsChan}
+	if kinds.includes(api.ResourceKindReplicaSet) {
+		workloads.ReplicaSetList = *(<-rsChan)
+	}
+	if kinds.includes(api.ResourceKindJob) {
+		workloads.JobList = *(<-jobChan)
+	}
+	if kinds.includes(api.ResourceKindCronJob) {
+		workloads.CronJobList = *(<-cjChan)
+	}
+	if kinds.includes(api.ResourceKindReplicationController) {
+		workloads.ReplicationControllerList = *(<-rcChan)
+	}
+	if kinds.includes(api.ResourceKindDeployment) {
+		workloads.DeploymentList = *(<-deploymentChan)
+	}
+	if kinds.includes(api.ResourceKindPod) {
+		workloads.PodList = *(<-podChan)
+	}
+	if kinds.includes(api.ResourceKindDaemonSet) {
+		workloads.DaemonSetList = *(<-dsChan)
+	}
+	if kinds.includes(api.ResourceKindStatefulSet) {
+		workloads.StatefulSetList = *(<-ssChan)
 	}
 
 	workloads.Errors = errors.MergeErrors(workloads.DaemonSetList.Errors, workloads.DeploymentList.Errors,
@@ -34,7 +34,9 @@ import (
 	batch "k8s.io/api/batch/v1"
 	batch2 "k8s.io/api/batch/v1beta1"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetWorkloadsFromChannels(t *testing.T) {
@@ -263,20 +265,20 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 				Error: make(chan error, 1),
 			},
 			ReplicationControllerList: common.ReplicationControllerListChannel{
-				List:  make(chan *v1.ReplicationControllerList, 1),
-				Error: make(chan error, 1),
+				List:  make(chan *v1.ReplicationControllerList, 2),
+				Error: make(chan error, 2),
 			},
 			DaemonSetList: common.DaemonSetListChannel{
-				List:  make(chan *apps.DaemonSetList, 1),
-				Error: make(chan error, 1),
+				List:  make(chan *apps.DaemonSetList, 2),
+				Error: make(chan error, 2),
 			},
 			DeploymentList: common.DeploymentListChannel{
-				List:  make(chan *apps.DeploymentList, 1),
-				Error: make(chan error, 1),
+				List:  make(chan *apps.DeploymentList, 2),
+				Error: make(chan error, 2),
 			},
 			StatefulSetList: common.StatefulSetListChannel{
-				List:  make(chan *apps.StatefulSetList, 1),
-				Error: make(chan error, 1),
+				List:  make(chan *apps.StatefulSetList, 2),
+				Error: make(chan error, 2),
 			},
 			NodeList: common.NodeListChannel{
 				List:  make(chan *v1.NodeList, 6),
@@ -307,15 +309,23 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		channels.CronJobList.Error <- nil
 		channels.CronJobList.List <- &c.k8sCronJobs
 
+		channels.DaemonSetList.Error <- nil
+		channels.DaemonSetList.List <- &c.k8sDaemonSet
 		channels.DaemonSetList.Error <- nil
 		channels.DaemonSetList.List <- &c.k8sDaemonSet
 
+		channels.DeploymentList.Error <- nil
+		channels.DeploymentList.List <- &c.k8sDeployment
 		channels.DeploymentList.Error <- nil
 		channels.DeploymentList.List <- &c.k8sDeployment
 
+		channels.ReplicationControllerList.List <- &c.k8sRc
+		channels.ReplicationControllerList.Error <- nil
 		channels.ReplicationControllerList.List <- &c.k8sRc
 		channels.ReplicationControllerList.Error <- nil
 
+		channels.StatefulSetList.List <- &c.k8sStatefulSet
+		channels.StatefulSetList.Error <- nil
 		channels.StatefulSetList.List <- &c.k8sStatefulSet
 		channels.StatefulSetList.Error <- nil
 
@@ -379,7 +389,7 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		channels.EventList.List <- eventList
 		channels.EventList.Error <- nil
 
-		actual, err := GetWorkloadsFromChannels(channels, nil, dataselect.DefaultDataSelect)
+		actual, err := GetWorkloadsFromChannels(channels, nil, dataselect.DefaultDataSelect, nil)
 		// Fix daemonset pod info desired address
 		for i := range actual.DaemonSetList.DaemonSets {
 			actual.DaemonSetList.DaemonSets[i].Pods.Desired = nil
@@ -393,3 +403,121 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		}
 	}
 }
+
+func TestGetWorkloadsWithKinds(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	kinds := ParseWorkloadKinds("deployment")
+
+	_, err := GetWorkloads(fakeClient, nil, common.NewNamespaceQuery(nil),
+		dataselect.NoDataSelect, kinds, "")
+	if err != nil {
+		t.Errorf("GetWorkloads(client, nil, nsQuery, dsQuery, %v) returned error: %s", kinds, err)
+	}
+
+	queried := map[string]bool{}
+	for _, action := range fakeClient.Actions() {
+		queried[action.GetResource().Resource] = true
+	}
+
+	// Deployments need replica sets, pods and events to compute their pod info.
+	for _, resource := range []string{"deployments", "replicasets", "pods", "events"} {
+		if !queried[resource] {
+			t.Errorf("GetWorkloads(client, nil, nsQuery, dsQuery, %v) did not query %s", kinds,
+				resource)
+		}
+	}
+
+	for _, resource := range []string{"jobs", "cronjobs", "daemonsets", "statefulsets",
+		"replicationcontrollers"} {
+		if queried[resource] {
+			t.Errorf("GetWorkloads(client, nil, nsQuery, dsQuery, %v) queried excluded resource %s",
+				kinds, resource)
+		}
+	}
+}
+
+func TestGetWorkloadsResourceTotals(t *testing.T) {
+	replicasOne := int32(1)
+	replicasThree := int32(3)
+	template := v1.PodTemplateSpec{
+		ObjectMeta: metaV1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "test-container",
+					Image: "test-image",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("100m"),
+							v1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	fakeClient := fake.NewSimpleClientset(
+		&apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "deployment-1", Namespace: "default"},
+			Spec: apps.DeploymentSpec{
+				Replicas: &replicasOne,
+				Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+				Template: template,
+			},
+		},
+		&apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "deployment-2", Namespace: "default"},
+			Spec: apps.DeploymentSpec{
+				Replicas: &replicasThree,
+				Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+				Template: template,
+			},
+		})
+
+	actual, err := GetWorkloads(fakeClient, nil, common.NewNamespaceQuery(nil),
+		dataselect.NoDataSelect, ParseWorkloadKinds("deployment"), "")
+	if err != nil {
+		t.Fatalf("GetWorkloads(client, nil, nsQuery, dsQuery, deployment) returned error: %s", err)
+	}
+
+	expected := ResourceTotals{
+		CPURequests:    400,
+		MemoryRequests: 4 * 64 * 1024 * 1024,
+	}
+	if !reflect.DeepEqual(actual.ResourceTotals, expected) {
+		t.Errorf("GetWorkloads(client, nil, nsQuery, dsQuery, deployment) == \ngot totals %#v, \nexpected %#v",
+			actual.ResourceTotals, expected)
+	}
+}
+
+func TestGetWorkloadsWithLabelSelector(t *testing.T) {
+	replicas := int32(1)
+	newDeployment := func(name string, labels map[string]string) *apps.Deployment {
+		return &apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec: apps.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metaV1.LabelSelector{MatchLabels: labels},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metaV1.ObjectMeta{Labels: labels},
+				},
+			},
+		}
+	}
+	fakeClient := fake.NewSimpleClientset(
+		newDeployment("deployment-payments", map[string]string{"team": "payments"}),
+		newDeployment("deployment-search", map[string]string{"team": "search"}))
+
+	actual, err := GetWorkloads(fakeClient, nil, common.NewNamespaceQuery(nil),
+		dataselect.NoDataSelect, ParseWorkloadKinds("deployment"), "team=payments")
+	if err != nil {
+		t.Fatalf("GetWorkloads(client, nil, nsQuery, dsQuery, deployment, team=payments) returned error: %s",
+			err)
+	}
+
+	if len(actual.DeploymentList.Deployments) != 1 ||
+		actual.DeploymentList.Deployments[0].ObjectMeta.Name != "deployment-payments" {
+		t.Errorf("GetWorkloads(client, nil, nsQuery, dsQuery, deployment, team=payments) == \ngot %#v, "+
+			"expected only deployment-payments", actual.DeploymentList.Deployments)
+	}
+}
@@ -59,7 +59,7 @@ func Search(client kubernetes.Interface, metricClient metricapi.MetricClient, ns
 		return &SearchResult{}, err
 	}
 
-	workloadsResources, err := workload.GetWorkloads(client, metricClient, nsQuery, dsQuery)
+	workloadsResources, err := workload.GetWorkloads(client, metricClient, nsQuery, dsQuery, nil, "")
 	if err != nil {
 		return &SearchResult{}, err
 	}